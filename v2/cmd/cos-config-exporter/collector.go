@@ -0,0 +1,262 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/ibm-cos-sdk-go-config/v2/crn"
+	rc "github.com/IBM/ibm-cos-sdk-go-config/v2/resourceconfigurationv1"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const namespace = "cos_config"
+
+// policyStatuses are the values of the status label of cos_config_backup_policy_status.
+var policyStatuses = []string{
+	rc.BackupPolicy_PolicyStatus_ActionNeeded,
+	rc.BackupPolicy_PolicyStatus_Active,
+	rc.BackupPolicy_PolicyStatus_Degraded,
+	rc.BackupPolicy_PolicyStatus_Failed,
+	rc.BackupPolicy_PolicyStatus_Initializing,
+	rc.BackupPolicy_PolicyStatus_Pending,
+}
+
+func newDesc(name string, help string, labels ...string) *prometheus.Desc {
+	return prometheus.NewDesc(prometheus.BuildFQName(namespace, "", name), help, labels, nil)
+}
+
+var (
+	bucketObjectCount         = newDesc("bucket_object_count", "Number of objects in the bucket.", "bucket")
+	bucketBytesUsed           = newDesc("bucket_bytes_used", "Bytes used by the current versions of the objects in the bucket.", "bucket")
+	bucketNoncurrentBytesUsed = newDesc("bucket_noncurrent_bytes_used", "Bytes used by the noncurrent versions of the objects in the bucket.", "bucket")
+	bucketDeleteMarkerCount   = newDesc("bucket_delete_marker_count", "Number of delete markers in the bucket.", "bucket")
+	bucketHardQuota           = newDesc("bucket_hard_quota_bytes", "Maximum bytes the bucket may use; absent when the bucket has no quota.", "bucket")
+	backupVaultBytesUsed      = newDesc("backup_vault_bytes_used", "Bytes used by the backup vault.", "backup_vault", "region")
+	policyStatus              = newDesc("backup_policy_status", "Status of the backup policy; 1 for the current status and 0 for the others.",
+		"bucket", "policy_id", "policy_name", "backup_vault", "status")
+	policyInitialSyncProgress = newDesc("backup_policy_initial_sync_progress_percent", "Progress of the initial sync of a pending or initializing backup policy.",
+		"bucket", "policy_id", "policy_name")
+	restoreProgress = newDesc("restore_progress_percent", "Progress of a restore that is initializing or running.",
+		"backup_vault", "restore_id", "source_bucket", "target_bucket")
+	rangeAge = newDesc("recovery_range_age_seconds", "Time since the start of the latest recovery range of a bucket.",
+		"backup_vault", "recovery_range_id", "source_bucket")
+	rangeLastPointAge = newDesc("recovery_range_last_point_age_seconds", "Time since the latest recovery point of the latest recovery range of a bucket.",
+		"backup_vault", "recovery_range_id", "source_bucket")
+	scrapeErrors   = newDesc("scrape_errors", "Number of requests that failed during the scrape.")
+	scrapeDuration = newDesc("scrape_duration_seconds", "Time the scrape took.")
+)
+
+// collector is a prometheus.Collector that reads the state of the configured buckets and backup vaults from the
+// Resource Configuration API on every scrape.
+type collector struct {
+	service *rc.ResourceConfigurationV1
+
+	// The buckets whose configuration and backup policies are exported.
+	buckets []string
+
+	// The backup vaults whose usage, restores and recovery ranges are exported.
+	vaults []string
+
+	// When set, the backup vaults of this service instance are exported too.
+	serviceInstanceID string
+
+	// The time a scrape may take.
+	timeout time.Duration
+
+	// Where errors are logged.
+	log io.Writer
+
+	now func() time.Time
+}
+
+// Describe sends the descriptors of every metric the collector can export.
+func (c *collector) Describe(ch chan<- *prometheus.Desc) {
+	for _, desc := range []*prometheus.Desc{
+		bucketObjectCount, bucketBytesUsed, bucketNoncurrentBytesUsed, bucketDeleteMarkerCount, bucketHardQuota,
+		backupVaultBytesUsed, policyStatus, policyInitialSyncProgress, restoreProgress, rangeAge, rangeLastPointAge,
+		scrapeErrors, scrapeDuration,
+	} {
+		ch <- desc
+	}
+}
+
+// Collect reads the current state from the API and sends it as metrics. A bucket or vault that cannot be read is
+// left out of the scrape and counted in cos_config_scrape_errors.
+func (c *collector) Collect(ch chan<- prometheus.Metric) {
+	start := c.now()
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	errors := 0
+	fail := func(format string, args ...interface{}) {
+		errors++
+		fmt.Fprintf(c.log, "cos-config-exporter: "+format+"\n", args...)
+	}
+
+	seenBuckets := map[string]bool{}
+	for _, bucket := range c.buckets {
+		if !seenBuckets[bucket] {
+			seenBuckets[bucket] = true
+			c.collectBucket(ctx, ch, bucket, fail)
+		}
+	}
+
+	vaults := append([]string{}, c.vaults...)
+	if c.serviceInstanceID != "" {
+		found, err := c.listBackupVaults(ctx)
+		if err != nil {
+			fail("unable to list the backup vaults of %s: %s", c.serviceInstanceID, err.Error())
+		}
+		vaults = append(vaults, found...)
+	}
+	seenVaults := map[string]bool{}
+	for _, vault := range vaults {
+		if !seenVaults[vault] {
+			seenVaults[vault] = true
+			c.collectBackupVault(ctx, ch, vault, start, fail)
+		}
+	}
+
+	ch <- prometheus.MustNewConstMetric(scrapeErrors, prometheus.GaugeValue, float64(errors))
+	ch <- prometheus.MustNewConstMetric(scrapeDuration, prometheus.GaugeValue, c.now().Sub(start).Seconds())
+}
+
+func (c *collector) collectBucket(ctx context.Context, ch chan<- prometheus.Metric, bucket string, fail func(string, ...interface{})) {
+	config, _, err := c.service.GetBucketConfigWithContext(ctx, c.service.NewGetBucketConfigOptions(bucket))
+	if err != nil {
+		fail("unable to get bucket %s: %s", bucket, err.Error())
+	} else {
+		for desc, value := range map[*prometheus.Desc]*int64{
+			bucketObjectCount:         config.ObjectCount,
+			bucketBytesUsed:           config.BytesUsed,
+			bucketNoncurrentBytesUsed: config.NoncurrentBytesUsed,
+			bucketDeleteMarkerCount:   config.DeleteMarkerCount,
+			bucketHardQuota:           config.HardQuota,
+		} {
+			if value != nil {
+				ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, float64(*value), bucket)
+			}
+		}
+	}
+
+	policies, _, err := c.service.ListBackupPoliciesWithContext(ctx, c.service.NewListBackupPoliciesOptions(bucket))
+	if err != nil {
+		fail("unable to list the backup policies of bucket %s: %s", bucket, err.Error())
+		return
+	}
+	for _, policy := range policies.BackupPolicies {
+		policyID := core.StringNilMapper(policy.PolicyID)
+		policyName := core.StringNilMapper(policy.PolicyName)
+		vault := resourceName(core.StringNilMapper(policy.TargetBackupVaultCrn))
+		current := core.StringNilMapper(policy.PolicyStatus)
+		for _, status := range policyStatuses {
+			value := 0.0
+			if status == current {
+				value = 1
+			}
+			ch <- prometheus.MustNewConstMetric(policyStatus, prometheus.GaugeValue, value, bucket, policyID, policyName, vault, status)
+		}
+		if policy.InitialSyncProgress != nil {
+			ch <- prometheus.MustNewConstMetric(policyInitialSyncProgress, prometheus.GaugeValue, *policy.InitialSyncProgress, bucket, policyID, policyName)
+		}
+	}
+}
+
+func (c *collector) collectBackupVault(ctx context.Context, ch chan<- prometheus.Metric, vault string, now time.Time, fail func(string, ...interface{})) {
+	backupVault, _, err := c.service.GetBackupVaultWithContext(ctx, c.service.NewGetBackupVaultOptions(vault))
+	if err != nil {
+		fail("unable to get backup vault %s: %s", vault, err.Error())
+	} else if backupVault.BytesUsed != nil {
+		ch <- prometheus.MustNewConstMetric(backupVaultBytesUsed, prometheus.GaugeValue, float64(*backupVault.BytesUsed),
+			vault, core.StringNilMapper(backupVault.Region))
+	}
+
+	restores, err := c.listRestores(ctx, vault)
+	if err != nil {
+		fail("unable to list the restores of backup vault %s: %s", vault, err.Error())
+	}
+	for _, restore := range restores {
+		switch core.StringNilMapper(restore.RestoreStatus) {
+		case rc.Restore_RestoreStatus_Initializing, rc.Restore_RestoreStatus_Running:
+		default:
+			continue
+		}
+		progress := 0.0
+		if restore.RestorePercentProgress != nil {
+			progress = float64(*restore.RestorePercentProgress)
+		}
+		ch <- prometheus.MustNewConstMetric(restoreProgress, prometheus.GaugeValue, progress, vault, core.StringNilMapper(restore.RestoreID),
+			resourceName(core.StringNilMapper(restore.SourceResourceCrn)), resourceName(core.StringNilMapper(restore.TargetResourceCrn)))
+	}
+
+	ranges, err := c.listLatestRecoveryRanges(ctx, vault)
+	if err != nil {
+		fail("unable to list the recovery ranges of backup vault %s: %s", vault, err.Error())
+	}
+	for _, recoveryRange := range ranges {
+		rangeID := core.StringNilMapper(recoveryRange.RecoveryRangeID)
+		source := resourceName(core.StringNilMapper(recoveryRange.SourceResourceCrn))
+		if recoveryRange.RangeStartTime != nil {
+			ch <- prometheus.MustNewConstMetric(rangeAge, prometheus.GaugeValue,
+				now.Sub(time.Time(*recoveryRange.RangeStartTime)).Seconds(), vault, rangeID, source)
+		}
+		if recoveryRange.RangeEndTime != nil {
+			ch <- prometheus.MustNewConstMetric(rangeLastPointAge, prometheus.GaugeValue,
+				now.Sub(time.Time(*recoveryRange.RangeEndTime)).Seconds(), vault, rangeID, source)
+		}
+	}
+}
+
+func (c *collector) listBackupVaults(ctx context.Context) ([]string, error) {
+	pager, err := c.service.NewBackupVaultsPager(c.service.NewListBackupVaultsOptions(c.serviceInstanceID))
+	if err != nil {
+		return nil, err
+	}
+	return pager.GetAllWithContext(ctx)
+}
+
+func (c *collector) listRestores(ctx context.Context, vault string) ([]rc.Restore, error) {
+	pager, err := c.service.NewRestoresPager(c.service.NewListRestoresOptions(vault))
+	if err != nil {
+		return nil, err
+	}
+	return pager.GetAllWithContext(ctx)
+}
+
+func (c *collector) listLatestRecoveryRanges(ctx context.Context, vault string) ([]rc.RecoveryRange, error) {
+	options := c.service.NewListRecoveryRangesOptions(vault)
+	options.SetLatest("true")
+	pager, err := c.service.NewRecoveryRangesPager(options)
+	if err != nil {
+		return nil, err
+	}
+	return pager.GetAllWithContext(ctx)
+}
+
+// resourceName returns the resource of the CRN "s", such as the bucket or backup vault name, or "s" itself if it is
+// not a CRN.
+func resourceName(s string) string {
+	if parsed, err := crn.Parse(s); err == nil && parsed.Resource != "" {
+		return parsed.Resource
+	}
+	return s
+}
@@ -0,0 +1,214 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Command cos-config-exporter exports the state of IBM Cloud Object Storage buckets, backup vaults, backup policies,
+// restores and recovery ranges as Prometheus metrics.
+//
+// Usage:
+//
+//	cos-config-exporter --buckets b1,b2 [--vaults v1,v2] [--instance-id <id>] [--listen-address :9876]
+//
+// The API key is taken from --apikey or $IBMCLOUD_API_KEY; when neither is set the client is configured from
+// --profile or the IBM Cloud SDK external configuration. The API is read on every scrape of /metrics.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/ibm-cos-sdk-go-config/v2/prometheusmetrics"
+	rc "github.com/IBM/ibm-cos-sdk-go-config/v2/resourceconfigurationv1"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const envAPIKey = "IBMCLOUD_API_KEY"
+
+// options are the command-line flags.
+type options struct {
+	listenAddress     string
+	metricsPath       string
+	buckets           string
+	vaults            string
+	serviceInstanceID string
+	scrapeTimeout     time.Duration
+	apiKey            string
+	iamURL            string
+	serviceURL        string
+	profile           string
+}
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	code := run(ctx, os.Args[1:], os.Stderr)
+	stop()
+	os.Exit(code)
+}
+
+// run serves metrics until "ctx" is done and returns the process exit code.
+func run(ctx context.Context, args []string, stderr io.Writer) int {
+	fs := flag.NewFlagSet("cos-config-exporter", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	var opts options
+	fs.StringVar(&opts.listenAddress, "listen-address", ":9876", "address to serve metrics on")
+	fs.StringVar(&opts.metricsPath, "metrics-path", "/metrics", "path to serve metrics on")
+	fs.StringVar(&opts.buckets, "buckets", "", "comma-separated buckets whose configuration and backup policies are exported")
+	fs.StringVar(&opts.vaults, "vaults", "", "comma-separated backup vaults whose usage, restores and recovery ranges are exported")
+	fs.StringVar(&opts.serviceInstanceID, "instance-id", "", "service instance whose backup vaults are all exported")
+	fs.DurationVar(&opts.scrapeTimeout, "scrape-timeout", 30*time.Second, "time a scrape may take")
+	fs.StringVar(&opts.apiKey, "apikey", "", "IBM Cloud IAM API key (default $"+envAPIKey+")")
+	fs.StringVar(&opts.iamURL, "iam-url", "", "IAM token service URL (default https://iam.cloud.ibm.com)")
+	fs.StringVar(&opts.serviceURL, "url", "", "Resource Configuration service URL (default "+rc.DefaultServiceURL+")")
+	fs.StringVar(&opts.profile, "profile", "", "profile to load from the profile file (default $"+rc.ProfileEnv+")")
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 2
+	}
+	if opts.buckets == "" && opts.vaults == "" && opts.serviceInstanceID == "" {
+		fmt.Fprintln(stderr, "cos-config-exporter: at least one of --buckets, --vaults and --instance-id is required")
+		return 2
+	}
+
+	service, err := newService(&opts)
+	if err != nil {
+		fmt.Fprintf(stderr, "cos-config-exporter: %s\n", err.Error())
+		return 1
+	}
+	handler, err := newHandler(newCollector(service, &opts, stderr), opts.metricsPath)
+	if err != nil {
+		fmt.Fprintf(stderr, "cos-config-exporter: %s\n", err.Error())
+		return 1
+	}
+
+	server := &http.Server{
+		Addr:              opts.listenAddress,
+		Handler:           handler,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+	fmt.Fprintf(stderr, "cos-config-exporter: serving metrics on %s%s\n", opts.listenAddress, opts.metricsPath)
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		fmt.Fprintf(stderr, "cos-config-exporter: %s\n", err.Error())
+		return 1
+	}
+	return 0
+}
+
+// newService builds the Resource Configuration client from the flags. With a profile, --url and --iam-url override
+// the endpoints of the profile.
+func newService(opts *options) (*rc.ResourceConfigurationV1, error) {
+	if opts.profile != "" || os.Getenv(rc.ProfileEnv) != "" {
+		service, err := rc.NewFromProfile(opts.profile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create client: %s", err.Error())
+		}
+		if opts.serviceURL != "" {
+			if err = service.SetServiceURL(opts.serviceURL); err != nil {
+				return nil, err
+			}
+		}
+		if opts.iamURL != "" {
+			authenticator, ok := service.Service.Options.Authenticator.(*core.IamAuthenticator)
+			if !ok {
+				return nil, fmt.Errorf("--iam-url requires an IAM authenticator")
+			}
+			authenticator.URL = opts.iamURL
+		}
+		return service, nil
+	}
+
+	apiKey := opts.apiKey
+	if apiKey == "" {
+		apiKey = os.Getenv(envAPIKey)
+	}
+	serviceOptions := &rc.ResourceConfigurationV1Options{
+		URL: opts.serviceURL,
+	}
+	if apiKey != "" {
+		serviceOptions.Authenticator = &core.IamAuthenticator{
+			ApiKey: apiKey,
+			URL:    opts.iamURL,
+		}
+	}
+	service, err := rc.NewResourceConfigurationV1UsingExternalConfig(serviceOptions)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create client (set --apikey or $%s): %s", envAPIKey, err.Error())
+	}
+	return service, nil
+}
+
+func newCollector(service *rc.ResourceConfigurationV1, opts *options, log io.Writer) *collector {
+	return &collector{
+		service:           service,
+		buckets:           splitList(opts.buckets),
+		vaults:            splitList(opts.vaults),
+		serviceInstanceID: opts.serviceInstanceID,
+		timeout:           opts.scrapeTimeout,
+		log:               log,
+		now:               time.Now,
+	}
+}
+
+// newHandler serves the metrics of "c", and the request metrics of its client, on "metricsPath" and a short landing
+// page on "/".
+func newHandler(c *collector, metricsPath string) (http.Handler, error) {
+	registry := prometheus.NewRegistry()
+	if err := registry.Register(c); err != nil {
+		return nil, err
+	}
+	requestMetrics, err := prometheusmetrics.NewRequestMetrics(registry)
+	if err != nil {
+		return nil, err
+	}
+	c.service.SetRequestMetrics(requestMetrics)
+	mux := http.NewServeMux()
+	mux.Handle(metricsPath, promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+	mux.HandleFunc("/", func(res http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/" {
+			http.NotFound(res, req)
+			return
+		}
+		fmt.Fprintf(res, "<html><body><h1>COS Resource Configuration exporter</h1><p><a href=%q>Metrics</a></p></body></html>\n", metricsPath)
+	})
+	return mux, nil
+}
+
+// splitList splits a comma-separated flag value, trimming spaces and dropping empty entries.
+func splitList(s string) []string {
+	var list []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
@@ -0,0 +1,168 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+
+	rc "github.com/IBM/ibm-cos-sdk-go-config/v2/resourceconfigurationv1"
+)
+
+const vaultCrnPrefix = "crn:v1:bluemix:public:cloud-object-storage:global:a/account:instance:backup-vault:"
+const bucketCrnPrefix = "crn:v1:bluemix:public:cloud-object-storage:global:a/account:instance:bucket:"
+
+func newAPIServer(t *testing.T) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		res.Header().Set("Content-Type", "application/json")
+		switch req.URL.Path {
+		case "/b/my-bucket":
+			fmt.Fprint(res, `{"name": "my-bucket", "object_count": 12, "bytes_used": 3000, "noncurrent_bytes_used": 500, "delete_marker_count": 2, "hard_quota": 10000}`)
+		case "/b/broken-bucket":
+			res.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(res, `{"errors": [{"code": "forbidden", "message": "Access denied"}]}`)
+		case "/buckets/my-bucket/backup_policies":
+			fmt.Fprintf(res, `{"backup_policies": [{"policy_id": "p1", "policy_name": "daily", "target_backup_vault_crn": "%smy-vault",
+				"policy_status": "initializing", "initial_sync_progress": 42.5}]}`, vaultCrnPrefix)
+		case "/buckets/broken-bucket/backup_policies":
+			fmt.Fprint(res, `{"backup_policies": []}`)
+		case "/backup_vaults":
+			assert.Equal(t, "instance", req.URL.Query().Get("service_instance_id"))
+			fmt.Fprint(res, `{"backup_vaults": ["my-vault"]}`)
+		case "/backup_vaults/my-vault":
+			fmt.Fprint(res, `{"backup_vault_name": "my-vault", "region": "us-south", "bytes_used": 7000}`)
+		case "/backup_vaults/my-vault/restores":
+			fmt.Fprintf(res, `{"restores": [
+				{"restore_id": "r1", "restore_status": "running", "restore_percent_progress": 30, "source_resource_crn": "%[1]smy-bucket", "target_resource_crn": "%[1]starget"},
+				{"restore_id": "r2", "restore_status": "complete", "restore_percent_progress": 100}
+			]}`, bucketCrnPrefix)
+		case "/backup_vaults/my-vault/recovery_ranges":
+			assert.Equal(t, "true", req.URL.Query().Get("latest"))
+			fmt.Fprintf(res, `{"recovery_ranges": [{"recovery_range_id": "range-1", "source_resource_crn": "%smy-bucket",
+				"range_start_time": "2025-06-01T00:00:00Z", "range_end_time": "2025-06-09T23:00:00Z"}]}`, bucketCrnPrefix)
+		default:
+			t.Errorf("unexpected request %s", req.URL.Path)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// scrape serves the metrics of "c" and returns the last of "scrapes" scrapes.
+func scrape(t *testing.T, c *collector, scrapes int) (body string) {
+	handler, err := newHandler(c, "/metrics")
+	assert.NoError(t, err)
+	server := httptest.NewServer(handler)
+	defer server.Close()
+	for i := 0; i < scrapes; i++ {
+		response, err := http.Get(server.URL + "/metrics")
+		assert.NoError(t, err)
+		data, _ := io.ReadAll(response.Body)
+		response.Body.Close()
+		assert.Equal(t, http.StatusOK, response.StatusCode, string(data))
+		body = string(data)
+	}
+	return
+}
+
+func TestCollector(t *testing.T) {
+	api := newAPIServer(t)
+	service, err := rc.NewResourceConfigurationV1(&rc.ResourceConfigurationV1Options{URL: api.URL, Authenticator: &core.NoAuthAuthenticator{}})
+	assert.NoError(t, err)
+	var log bytes.Buffer
+	c := newCollector(service, &options{buckets: "my-bucket,broken-bucket", vaults: "my-vault", serviceInstanceID: "instance", scrapeTimeout: time.Minute}, &log)
+	c.now = func() time.Time { return time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC) }
+
+	metrics := scrape(t, c, 1)
+	for _, line := range []string{
+		`cos_config_bucket_object_count{bucket="my-bucket"} 12`,
+		`cos_config_bucket_bytes_used{bucket="my-bucket"} 3000`,
+		`cos_config_bucket_noncurrent_bytes_used{bucket="my-bucket"} 500`,
+		`cos_config_bucket_delete_marker_count{bucket="my-bucket"} 2`,
+		`cos_config_bucket_hard_quota_bytes{bucket="my-bucket"} 10000`,
+		`cos_config_backup_vault_bytes_used{backup_vault="my-vault",region="us-south"} 7000`,
+		`cos_config_backup_policy_status{backup_vault="my-vault",bucket="my-bucket",policy_id="p1",policy_name="daily",status="initializing"} 1`,
+		`cos_config_backup_policy_status{backup_vault="my-vault",bucket="my-bucket",policy_id="p1",policy_name="daily",status="active"} 0`,
+		`cos_config_backup_policy_initial_sync_progress_percent{bucket="my-bucket",policy_id="p1",policy_name="daily"} 42.5`,
+		`cos_config_restore_progress_percent{backup_vault="my-vault",restore_id="r1",source_bucket="my-bucket",target_bucket="target"} 30`,
+		`cos_config_recovery_range_age_seconds{backup_vault="my-vault",recovery_range_id="range-1",source_bucket="my-bucket"} 777600`,
+		`cos_config_recovery_range_last_point_age_seconds{backup_vault="my-vault",recovery_range_id="range-1",source_bucket="my-bucket"} 3600`,
+		`cos_config_scrape_errors 1`,
+	} {
+		assert.Contains(t, metrics, line+"\n")
+	}
+	assert.NotContains(t, metrics, `restore_id="r2"`)
+	assert.NotContains(t, metrics, `bucket="broken-bucket"`)
+	assert.Contains(t, log.String(), "unable to get bucket broken-bucket")
+	assert.Contains(t, log.String(), "Access denied")
+
+	metrics = scrape(t, c, 2)
+	assert.Contains(t, metrics, `cos_config_client_requests_total{method="GET",operation="GetBucketConfig",status="200"}`)
+}
+
+func TestCollectorDuplicateTargets(t *testing.T) {
+	assert.Equal(t, []string{"a", "b", "a"}, splitList(" a, b,,a ,"))
+	assert.Empty(t, splitList(""))
+
+	api := newAPIServer(t)
+	service, err := rc.NewResourceConfigurationV1(&rc.ResourceConfigurationV1Options{URL: api.URL, Authenticator: &core.NoAuthAuthenticator{}})
+	assert.NoError(t, err)
+	var log bytes.Buffer
+	c := newCollector(service, &options{buckets: "my-bucket, my-bucket,", vaults: "my-vault,my-vault ", scrapeTimeout: time.Minute}, &log)
+	c.now = func() time.Time { return time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC) }
+
+	metrics := scrape(t, c, 1)
+	assert.Equal(t, 1, strings.Count(metrics, `cos_config_bucket_object_count{bucket="my-bucket"} 12`+"\n"))
+	assert.Equal(t, 1, strings.Count(metrics, `cos_config_backup_vault_bytes_used{backup_vault="my-vault",region="us-south"} 7000`+"\n"))
+	assert.Contains(t, metrics, "cos_config_scrape_errors 0\n")
+	assert.Empty(t, log.String())
+}
+
+func TestRunRequiresTargets(t *testing.T) {
+	var stderr bytes.Buffer
+	assert.Equal(t, 2, run(context.Background(), nil, &stderr))
+	assert.Contains(t, stderr.String(), "at least one of --buckets, --vaults and --instance-id is required")
+}
+
+func TestNewServiceWithProfile(t *testing.T) {
+	t.Setenv("TEST_EXPORTER_API_KEY", "key")
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+	config := "profiles:\n  test:\n    apikey_env: TEST_EXPORTER_API_KEY\n    iam_url: https://iam.example.com\n"
+	assert.NoError(t, os.WriteFile(configPath, []byte(config), 0600))
+	t.Setenv(rc.ProfileConfigFileEnv, configPath)
+
+	service, err := newService(&options{profile: "test", serviceURL: "https://config.example.com", iamURL: "https://iam.test.example.com"})
+	assert.NoError(t, err)
+	assert.Equal(t, "https://config.example.com", service.GetServiceURL())
+	assert.Equal(t, "https://iam.test.example.com", service.Service.Options.Authenticator.(*core.IamAuthenticator).URL)
+
+	service, err = newService(&options{profile: "test"})
+	assert.NoError(t, err)
+	assert.Equal(t, "https://iam.example.com", service.Service.Options.Authenticator.(*core.IamAuthenticator).URL)
+}
@@ -0,0 +1,69 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"os"
+)
+
+func bucketGet(c *cli, args []string) error {
+	fs := c.flagSet("bucket get")
+	args, err := c.parse(fs, args, 1)
+	if err != nil {
+		return err
+	}
+
+	service, err := c.service()
+	if err != nil {
+		return err
+	}
+	bucket, _, err := service.GetBucketConfigWithContext(c.ctx, service.NewGetBucketConfigOptions(args[0]))
+	if err != nil {
+		return err
+	}
+	return c.print(bucket)
+}
+
+func bucketUpdate(c *cli, args []string) error {
+	fs := c.flagSet("bucket update")
+	patchJSON := fs.String("patch", "", "JSON merge-patch with the configuration changes")
+	patchFile := fs.String("patch-file", "", "file holding the JSON merge-patch (\"-\" for standard input)")
+	ifMatch := fs.String("if-match", "", "only update if the bucket ETag matches")
+	args, err := c.parse(fs, args, 1)
+	if err != nil {
+		return err
+	}
+	patch, err := readPatch(os.Stdin, *patchJSON, *patchFile)
+	if err != nil {
+		return err
+	}
+
+	service, err := c.service()
+	if err != nil {
+		return err
+	}
+	options := service.NewUpdateBucketConfigOptions(args[0])
+	options.SetBucketPatch(patch)
+	if *ifMatch != "" {
+		options.SetIfMatch(*ifMatch)
+	}
+	if _, err = service.UpdateBucketConfigWithContext(c.ctx, options); err != nil {
+		return err
+	}
+	c.printStatus("Updated bucket %s", args[0])
+	return nil
+}
@@ -0,0 +1,182 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	rc "github.com/IBM/ibm-cos-sdk-go-config/v2/resourceconfigurationv1"
+)
+
+// Environment variables consulted when the corresponding flag is not set.
+const (
+	envAPIKey            = "IBMCLOUD_API_KEY"
+	envServiceInstanceID = "COSCONFIG_SERVICE_INSTANCE_ID"
+)
+
+// globalOptions are the flags accepted by every command.
+type globalOptions struct {
+	apiKey          string
+	iamURL          string
+	serviceURL      string
+	region          string
+	endpointType    string
+	credentialsFile string
+	profile         string
+	output          string
+}
+
+func (g *globalOptions) register(fs *flag.FlagSet) {
+	fs.StringVar(&g.apiKey, "apikey", g.apiKey, "IBM Cloud IAM API key (default $"+envAPIKey+")")
+	fs.StringVar(&g.iamURL, "iam-url", g.iamURL, "IAM token service URL (default https://iam.cloud.ibm.com)")
+	fs.StringVar(&g.serviceURL, "url", g.serviceURL, "Resource Configuration service URL (default "+rc.DefaultServiceURL+")")
+	fs.StringVar(&g.region, "endpoint-region", g.region, "region whose endpoint is used, or global (cannot be combined with --url)")
+	fs.StringVar(&g.endpointType, "endpoint-type", g.endpointType, "network used to reach the service: public, private or direct (cannot be combined with --url)")
+	fs.StringVar(&g.credentialsFile, "credentials-file", g.credentialsFile, "credentials file in the IBM Cloud SDK format, used when no API key is given")
+	fs.StringVar(&g.profile, "profile", g.profile, "profile to load from the profile file (default $"+rc.ProfileEnv+")")
+	fs.StringVar(&g.output, "output", g.output, "output format: table, json, yaml, csv, jsonpath=<expr> or go-template=<template> (default json)")
+	fs.StringVar(&g.output, "o", g.output, "shorthand for --output")
+}
+
+// service builds the Resource Configuration client from the global options.
+//
+// When --profile or $COSCONFIG_PROFILE is set, the credentials, endpoint and service instance come from that
+// profile of the profile file ($COSCONFIG_CONFIG_FILE or ~/.cosconfig/config.yaml); --url, --endpoint-region
+// and --endpoint-type still override the endpoint, and --iam-url the IAM token service.
+//
+// Otherwise the API key is taken from the --apikey flag, then from $IBMCLOUD_API_KEY. When neither is set,
+// the authenticator is loaded from the IBM Cloud SDK external configuration (the RESOURCE_CONFIGURATION_*
+// environment variables or the credentials file).
+func (c *cli) service() (*rc.ResourceConfigurationV1, error) {
+	if c.global.profile != "" || os.Getenv(rc.ProfileEnv) != "" {
+		service, err := rc.NewFromProfile(c.global.profile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create client: %s", err.Error())
+		}
+		url, err := c.endpointURL()
+		if err != nil {
+			return nil, err
+		}
+		if url != "" {
+			if err = service.SetServiceURL(url); err != nil {
+				return nil, err
+			}
+		}
+		if err = setIAMURL(service, c.global.iamURL); err != nil {
+			return nil, err
+		}
+		return service, nil
+	}
+
+	apiKey := c.global.apiKey
+	if apiKey == "" {
+		apiKey = os.Getenv(envAPIKey)
+	}
+	if c.global.credentialsFile != "" {
+		if err := os.Setenv("IBM_CREDENTIALS_FILE", c.global.credentialsFile); err != nil {
+			return nil, err
+		}
+	}
+
+	url, err := c.endpointURL()
+	if err != nil {
+		return nil, err
+	}
+	options := &rc.ResourceConfigurationV1Options{
+		URL: url,
+	}
+	if apiKey != "" {
+		options.Authenticator = &core.IamAuthenticator{
+			ApiKey: apiKey,
+			URL:    c.global.iamURL,
+		}
+	}
+
+	service, err := rc.NewResourceConfigurationV1UsingExternalConfig(options)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create client (set --apikey or $%s): %s", envAPIKey, err.Error())
+	}
+	return service, nil
+}
+
+// setIAMURL points the IAM authenticator of "service" at "iamURL", unless it is empty.
+func setIAMURL(service *rc.ResourceConfigurationV1, iamURL string) error {
+	if iamURL == "" {
+		return nil
+	}
+	authenticator, ok := service.Service.Options.Authenticator.(*core.IamAuthenticator)
+	if !ok {
+		return fmt.Errorf("--iam-url requires an IAM authenticator")
+	}
+	authenticator.URL = iamURL
+	return nil
+}
+
+// endpointURL returns the service URL selected by --url, --endpoint-region and --endpoint-type, or "" when none are set.
+func (c *cli) endpointURL() (string, error) {
+	if c.global.region == "" && c.global.endpointType == "" {
+		return c.global.serviceURL, nil
+	}
+	if c.global.serviceURL != "" {
+		return "", fmt.Errorf("--url cannot be combined with --endpoint-region or --endpoint-type")
+	}
+	return rc.GetServiceURLForEndpoint(c.global.region, c.global.endpointType)
+}
+
+// serviceInstanceID returns "flagValue", falling back to $COSCONFIG_SERVICE_INSTANCE_ID and then to the
+// service instance of the profile "service" was created from.
+func serviceInstanceID(service *rc.ResourceConfigurationV1, flagValue string) (string, error) {
+	if flagValue != "" {
+		return flagValue, nil
+	}
+	if id := os.Getenv(envServiceInstanceID); id != "" {
+		return id, nil
+	}
+	if service.ServiceInstanceID != "" {
+		return service.ServiceInstanceID, nil
+	}
+	return "", fmt.Errorf("a service instance id is required (set --instance-id, $%s or service_instance_id in the profile)", envServiceInstanceID)
+}
+
+// optionalBool is a boolean flag that records whether it was set at all.
+type optionalBool struct {
+	value *bool
+}
+
+func (b *optionalBool) String() string {
+	if b == nil || b.value == nil {
+		return ""
+	}
+	return strconv.FormatBool(*b.value)
+}
+
+func (b *optionalBool) Set(s string) error {
+	v, err := strconv.ParseBool(s)
+	if err != nil {
+		return err
+	}
+	b.value = &v
+	return nil
+}
+
+func (b *optionalBool) IsBoolFlag() bool {
+	return true
+}
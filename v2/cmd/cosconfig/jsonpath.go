@@ -0,0 +1,292 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// jsonPath is a compiled JSONPath template in the style used by kubectl, e.g.
+//
+//	{.backup_vault_name}
+//	{range .backup_policies[*]}{.policy_id}{"\t"}{.policy_status}{"\n"}{end}
+//
+// Supported path elements are fields (".name" or "['name']"), array indexes ("[0]", "[-1]") and
+// wildcards ("[*]", ".*"). Text outside of braces is copied to the output unchanged.
+type jsonPath struct {
+	nodes []jsonPathNode
+}
+
+// jsonPathNode is one element of a compiled template: literal text, a path, or a range block.
+type jsonPathNode struct {
+	text    *string
+	path    []jsonPathStep
+	body    []jsonPathNode
+	isRange bool
+}
+
+// jsonPathStep selects children of a value: a field name, an index, or every child (wildcard).
+type jsonPathStep struct {
+	field    string
+	index    int
+	isIndex  bool
+	wildcard bool
+}
+
+// parseJSONPath compiles "template".
+func parseJSONPath(template string) (*jsonPath, error) {
+	var nodes []jsonPathNode
+
+	// Enclosing node lists and the open range nodes, one entry per unclosed {range}.
+	var parents [][]jsonPathNode
+	var ranges []jsonPathNode
+
+	for len(template) > 0 {
+		open := strings.IndexByte(template, '{')
+		if open < 0 {
+			text := template
+			nodes = append(nodes, jsonPathNode{text: &text})
+			break
+		}
+		if open > 0 {
+			text := template[:open]
+			nodes = append(nodes, jsonPathNode{text: &text})
+		}
+		end := findActionEnd(template, open+1)
+		if end < 0 {
+			return nil, fmt.Errorf("unclosed action in JSONPath template")
+		}
+		action := strings.TrimSpace(template[open+1 : end])
+		template = template[end+1:]
+
+		switch {
+		case action == "end":
+			if len(ranges) == 0 {
+				return nil, fmt.Errorf("{end} without matching {range}")
+			}
+			node := ranges[len(ranges)-1]
+			node.body = nodes
+			ranges = ranges[:len(ranges)-1]
+			nodes = append(parents[len(parents)-1], node)
+			parents = parents[:len(parents)-1]
+		case strings.HasPrefix(action, "range "):
+			path, err := parseJSONPathSteps(strings.TrimSpace(strings.TrimPrefix(action, "range ")))
+			if err != nil {
+				return nil, err
+			}
+			ranges = append(ranges, jsonPathNode{isRange: true, path: path})
+			parents = append(parents, nodes)
+			nodes = nil
+		case strings.HasPrefix(action, `"`) || strings.HasPrefix(action, `'`):
+			text, err := unquoteJSONPathLiteral(action)
+			if err != nil {
+				return nil, err
+			}
+			nodes = append(nodes, jsonPathNode{text: &text})
+		default:
+			path, err := parseJSONPathSteps(action)
+			if err != nil {
+				return nil, err
+			}
+			nodes = append(nodes, jsonPathNode{path: path})
+		}
+	}
+	if len(ranges) != 0 {
+		return nil, fmt.Errorf("{range} without matching {end}")
+	}
+	return &jsonPath{nodes: nodes}, nil
+}
+
+// findActionEnd returns the index of the "}" closing the action starting at "start", skipping quoted text.
+func findActionEnd(template string, start int) int {
+	var quote byte
+	for i := start; i < len(template); i++ {
+		c := template[i]
+		switch {
+		case quote != 0 && c == '\\':
+			i++
+		case quote != 0 && c == quote:
+			quote = 0
+		case quote == 0 && (c == '"' || c == '\''):
+			quote = c
+		case quote == 0 && c == '}':
+			return i
+		}
+	}
+	return -1
+}
+
+func unquoteJSONPathLiteral(literal string) (string, error) {
+	if strings.HasPrefix(literal, "'") {
+		literal = `"` + strings.ReplaceAll(strings.Trim(literal, "'"), `"`, `\"`) + `"`
+	}
+	text, err := strconv.Unquote(literal)
+	if err != nil {
+		return "", fmt.Errorf("invalid string literal %s in JSONPath template", literal)
+	}
+	return text, nil
+}
+
+// parseJSONPathSteps compiles a path such as ".recovery_ranges[0].retention.delete_after_days".
+func parseJSONPathSteps(path string) ([]jsonPathStep, error) {
+	path = strings.TrimPrefix(path, "$")
+	steps := []jsonPathStep{}
+	for len(path) > 0 {
+		switch path[0] {
+		case '.':
+			path = path[1:]
+			n := strings.IndexAny(path, ".[")
+			if n < 0 {
+				n = len(path)
+			}
+			name := path[:n]
+			path = path[n:]
+			if name == "" {
+				if len(path) > 0 && path[0] == '[' {
+					continue
+				}
+				if len(steps) == 0 && len(path) == 0 {
+					// "{.}" selects the current value.
+					return steps, nil
+				}
+				return nil, fmt.Errorf("empty field name in JSONPath")
+			}
+			if name == "*" {
+				steps = append(steps, jsonPathStep{wildcard: true})
+			} else {
+				steps = append(steps, jsonPathStep{field: name})
+			}
+		case '[':
+			end := strings.IndexByte(path, ']')
+			if end < 0 {
+				return nil, fmt.Errorf("unclosed '[' in JSONPath")
+			}
+			selector := strings.TrimSpace(path[1:end])
+			path = path[end+1:]
+			switch {
+			case selector == "*":
+				steps = append(steps, jsonPathStep{wildcard: true})
+			case strings.HasPrefix(selector, "'") || strings.HasPrefix(selector, `"`):
+				name, err := unquoteJSONPathLiteral(selector)
+				if err != nil {
+					return nil, err
+				}
+				steps = append(steps, jsonPathStep{field: name})
+			default:
+				index, err := strconv.Atoi(selector)
+				if err != nil {
+					return nil, fmt.Errorf("unsupported JSONPath selector [%s]", selector)
+				}
+				steps = append(steps, jsonPathStep{index: index, isIndex: true})
+			}
+		default:
+			return nil, fmt.Errorf("JSONPath must start with '.', got %q", path)
+		}
+	}
+	return steps, nil
+}
+
+// execute renders the template against "data", which must be a generic JSON value.
+func (p *jsonPath) execute(w io.Writer, data interface{}) error {
+	return executeJSONPathNodes(w, p.nodes, data)
+}
+
+func executeJSONPathNodes(w io.Writer, nodes []jsonPathNode, data interface{}) error {
+	for _, node := range nodes {
+		switch {
+		case node.text != nil:
+			if _, err := io.WriteString(w, *node.text); err != nil {
+				return err
+			}
+		case node.isRange:
+			values := selectJSONPath(node.path, data)
+			if len(values) == 1 {
+				if array, ok := values[0].([]interface{}); ok {
+					values = array
+				}
+			}
+			for _, value := range values {
+				if err := executeJSONPathNodes(w, node.body, value); err != nil {
+					return err
+				}
+			}
+		default:
+			values := selectJSONPath(node.path, data)
+			formatted := make([]string, 0, len(values))
+			for _, value := range values {
+				formatted = append(formatted, formatJSONPathValue(value))
+			}
+			if _, err := io.WriteString(w, strings.Join(formatted, " ")); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// selectJSONPath returns every value reached by following "steps" from "data". Missing fields select nothing.
+func selectJSONPath(steps []jsonPathStep, data interface{}) []interface{} {
+	values := []interface{}{data}
+	for _, step := range steps {
+		var next []interface{}
+		for _, value := range values {
+			switch v := value.(type) {
+			case map[string]interface{}:
+				if step.wildcard {
+					for _, key := range sortedKeys(v) {
+						next = append(next, v[key])
+					}
+				} else if child, ok := v[step.field]; ok && !step.isIndex {
+					next = append(next, child)
+				}
+			case []interface{}:
+				if step.wildcard {
+					next = append(next, v...)
+				} else if step.isIndex {
+					index := step.index
+					if index < 0 {
+						index += len(v)
+					}
+					if index >= 0 && index < len(v) {
+						next = append(next, v[index])
+					}
+				}
+			}
+		}
+		values = next
+	}
+	return values
+}
+
+func formatJSONPathValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(data)
+	}
+}
@@ -0,0 +1,296 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Command cosconfig is a command-line client for the IBM COS Resource Configuration API.
+//
+// Usage:
+//
+//	cosconfig [global flags] <group> <command> [arguments] [flags]
+//
+// Run "cosconfig help" for the list of commands.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+)
+
+// command is a single leaf command such as "vault get".
+type command struct {
+	name    string
+	usage   string
+	summary string
+	run     func(c *cli, args []string) error
+}
+
+// commandGroup is a set of commands that operate on the same kind of resource.
+type commandGroup struct {
+	name     string
+	summary  string
+	commands []command
+}
+
+var commandGroups = []commandGroup{
+	{
+		name:    "bucket",
+		summary: "Read and update bucket configuration",
+		commands: []command{
+			{"get", "bucket get <bucket>", "Show the configuration of a bucket", bucketGet},
+			{"update", "bucket update <bucket> (--patch <json> | --patch-file <file>) [--if-match <etag>]", "Update the configuration of a bucket", bucketUpdate},
+		},
+	},
+	{
+		name:    "vault",
+		summary: "Manage backup vaults",
+		commands: []command{
+			{"list", "vault list --instance-id <id>", "List the backup vaults of a service instance", vaultList},
+			{"create", "vault create <vault> --instance-id <id> --region <region> [--kms-key-crn <crn>] [--management-events] [--usage-metrics]", "Create a backup vault", vaultCreate},
+			{"get", "vault get <vault>", "Show a backup vault", vaultGet},
+			{"update", "vault update <vault> [--management-events=<bool>] [--usage-metrics=<bool>] [--if-match <etag>]", "Update a backup vault", vaultUpdate},
+			{"delete", "vault delete <vault> [--buckets b1,b2] [--cascade --confirm <vault>]", "Delete a backup vault nothing depends on", vaultDelete},
+		},
+	},
+	{
+		name:    "policy",
+		summary: "Manage bucket backup policies",
+		commands: []command{
+			{"list", "policy list <bucket>", "List the backup policies of a bucket", policyList},
+			{"create", "policy create <bucket> --name <name> --vault-crn <crn> --retention-days <days> [--backup-type continuous]", "Create a backup policy", policyCreate},
+			{"get", "policy get <bucket> <policy-id>", "Show a backup policy", policyGet},
+			{"delete", "policy delete <bucket> <policy-id>", "Delete a backup policy", policyDelete},
+			{"migrate", "policy migrate <bucket> <policy-id> --vault-crn <crn> [--name <name>] [--retention-days <n>] [--keep-old]", "Move the backups of a bucket to another backup vault", policyMigrate},
+		},
+	},
+	{
+		name:    "range",
+		summary: "Inspect recovery ranges",
+		commands: []command{
+			{"list", "range list <vault> [--source-crn <crn>] [--latest]", "List the recovery ranges of a backup vault", rangeList},
+			{"get", "range get <vault> <range-id>", "Show a recovery range", rangeGet},
+			{"patch", "range patch <vault> <range-id> --retention-days <days>", "Change the retention of a recovery range", rangePatch},
+			{"apply-rules", "range apply-rules <vault> --rules <file> [--dry-run]", "Bring the retention of recovery ranges in line with rules", rangeApplyRules},
+		},
+	},
+	{
+		name:    "restore",
+		summary: "Start and track restores",
+		commands: []command{
+			{"create", "restore create <vault> --range-id <id> --point-in-time <time> --target-crn <crn> [--type in_place]", "Start a restore", restoreCreate},
+			{"list", "restore list <vault>", "List the restores of a backup vault", restoreList},
+			{"get", "restore get <vault> <restore-id>", "Show a restore", restoreGet},
+			{"resolve", "restore resolve <bucket-crn> --point-in-time <time> [--instance-id <id>]", "Find the recovery ranges that contain a point in time", restoreResolve},
+			{"bulk", "restore bulk <file> [--instance-id <id>] [--concurrency <n>] [--start-interval <duration>] [--interval <duration>]", "Restore the buckets listed in a JSON or YAML file", restoreBulk},
+			{"wait", "restore wait <vault> <restore-id> [--interval <duration>] [--timeout <duration>]", "Wait for a restore to complete", restoreWait},
+		},
+	},
+	{
+		name:    "report",
+		summary: "Report on backup coverage, retention and usage",
+		commands: []command{
+			{"coverage", "report coverage <bucket>... [--stale-after <duration>]", "Report the backup policies and recovery point gap of buckets", reportCoverage},
+			{"expiry", "report expiry [--instance-id <id>] [--days <n>]", "List the recovery ranges that expire soon", reportExpiry},
+			{"usage", "report usage <instance-id>... [--prices <file>]", "Report the storage used by backup vaults and its cost", reportUsage},
+		},
+	},
+	{
+		name:    "monitor",
+		summary: "Watch backup policies and restores",
+		commands: []command{
+			{"run", "monitor run [--buckets b1,b2] [--vaults v1,v2] [--interval <duration>] [--webhook <url>] [--once]", "Alert when backup policies or restores become unhealthy", monitorRun},
+		},
+	},
+}
+
+// errUsage is returned when the command line could not be understood; the usage has already been printed.
+var errUsage = errors.New("usage error")
+
+// cli holds the state shared by every command invocation.
+type cli struct {
+	ctx    context.Context
+	stdout io.Writer
+	stderr io.Writer
+	global globalOptions
+
+	// command is the command being run.
+	command *command
+
+	// printer renders results in the format selected with --output.
+	printer printer
+}
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	code := run(ctx, os.Args[1:], os.Stdout, os.Stderr)
+	stop()
+	os.Exit(code)
+}
+
+// run executes the command line "args" and returns the process exit code.
+func run(ctx context.Context, args []string, stdout io.Writer, stderr io.Writer) int {
+	c := &cli{
+		ctx:    ctx,
+		stdout: stdout,
+		stderr: stderr,
+	}
+
+	fs := c.flagSet("cosconfig")
+	fs.Usage = c.printUsage
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 2
+	}
+	args = fs.Args()
+
+	if len(args) == 0 || args[0] == "help" {
+		c.printUsage()
+		if len(args) == 0 {
+			return 2
+		}
+		return 0
+	}
+
+	group := findGroup(args[0])
+	if group == nil {
+		fmt.Fprintf(stderr, "cosconfig: unknown command group %q\n\n", args[0])
+		c.printUsage()
+		return 2
+	}
+	if len(args) < 2 {
+		c.printGroupUsage(group)
+		return 2
+	}
+	cmd := group.find(args[1])
+	if cmd == nil {
+		fmt.Fprintf(stderr, "cosconfig: unknown command %q\n\n", group.name+" "+args[1])
+		c.printGroupUsage(group)
+		return 2
+	}
+
+	c.command = cmd
+	err := cmd.run(c, args[2:])
+	switch {
+	case err == nil:
+		return 0
+	case errors.Is(err, flag.ErrHelp):
+		return 0
+	case errors.Is(err, errUsage):
+		return 2
+	default:
+		fmt.Fprintf(stderr, "cosconfig: %s\n", err.Error())
+		return 1
+	}
+}
+
+func findGroup(name string) *commandGroup {
+	for i := range commandGroups {
+		if commandGroups[i].name == name {
+			return &commandGroups[i]
+		}
+	}
+	return nil
+}
+
+func (group *commandGroup) find(name string) *command {
+	for i := range group.commands {
+		if group.commands[i].name == name {
+			return &group.commands[i]
+		}
+	}
+	return nil
+}
+
+// flagSet returns a new flag set for "name" with the global flags already registered.
+func (c *cli) flagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(c.stderr)
+	c.global.register(fs)
+	return fs
+}
+
+// oneOrMore is the "nargs" of commands that take any number of positional arguments but at least one.
+const oneOrMore = -1
+
+// parse parses the flags of the current command, allowing flags and positional arguments to be interleaved,
+// and verifies that exactly "nargs" positional arguments were supplied.
+func (c *cli) parse(fs *flag.FlagSet, args []string, nargs int) ([]string, error) {
+	fs.Usage = func() {
+		fmt.Fprintf(c.stderr, "Usage: cosconfig %s\n\nFlags:\n", c.command.usage)
+		fs.PrintDefaults()
+	}
+
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			if errors.Is(err, flag.ErrHelp) {
+				return nil, err
+			}
+			return nil, errUsage
+		}
+		args = fs.Args()
+		if len(args) == 0 {
+			break
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+
+	if nargs == oneOrMore && len(positional) == 0 {
+		fmt.Fprintf(c.stderr, "%s: expected at least 1 argument\n", fs.Name())
+		fs.Usage()
+		return nil, errUsage
+	}
+	if nargs != oneOrMore && len(positional) != nargs {
+		fmt.Fprintf(c.stderr, "%s: expected %d argument(s), got %d\n", fs.Name(), nargs, len(positional))
+		fs.Usage()
+		return nil, errUsage
+	}
+
+	var err error
+	c.printer, err = newPrinter(c.global.output)
+	if err != nil {
+		fmt.Fprintf(c.stderr, "%s: %s\n", fs.Name(), err.Error())
+		return nil, errUsage
+	}
+	return positional, nil
+}
+
+func (c *cli) printUsage() {
+	fmt.Fprintf(c.stderr, "Usage: cosconfig [global flags] <group> <command> [arguments] [flags]\n\nCommands:\n")
+	for _, group := range commandGroups {
+		for _, cmd := range group.commands {
+			fmt.Fprintf(c.stderr, "  %-16s %s\n", group.name+" "+cmd.name, cmd.summary)
+		}
+	}
+	fmt.Fprintf(c.stderr, "\nGlobal flags:\n")
+	fs := flag.NewFlagSet("cosconfig", flag.ContinueOnError)
+	fs.SetOutput(c.stderr)
+	new(globalOptions).register(fs)
+	fs.PrintDefaults()
+}
+
+func (c *cli) printGroupUsage(group *commandGroup) {
+	fmt.Fprintf(c.stderr, "%s\n\nUsage:\n", group.summary)
+	for _, cmd := range group.commands {
+		fmt.Fprintf(c.stderr, "  cosconfig %s\n", cmd.usage)
+	}
+}
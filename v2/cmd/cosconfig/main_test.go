@@ -0,0 +1,578 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	rc "github.com/IBM/ibm-cos-sdk-go-config/v2/resourceconfigurationv1"
+)
+
+// newTestServer returns a server that answers IAM token requests and delegates everything else to "handler".
+func newTestServer(t *testing.T, handler http.HandlerFunc) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/identity/token" {
+			res.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(res, `{"access_token": "token", "refresh_token": "refresh", "token_type": "Bearer", "expires_in": 3600, "expiration": %d}`, time.Now().Add(time.Hour).Unix())
+			return
+		}
+		assert.Equal(t, "Bearer token", req.Header.Get("Authorization"))
+		handler(res, req)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func runCommand(server *httptest.Server, args ...string) (int, string, string) {
+	var stdout, stderr bytes.Buffer
+	args = append([]string{"--apikey", "key", "--iam-url", server.URL, "--url", server.URL}, args...)
+	code := run(context.Background(), args, &stdout, &stderr)
+	return code, stdout.String(), stderr.String()
+}
+
+func TestUsage(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	assert.Equal(t, 2, run(context.Background(), nil, &stdout, &stderr))
+	assert.Contains(t, stderr.String(), "restore wait")
+
+	stderr.Reset()
+	assert.Equal(t, 2, run(context.Background(), []string{"widget", "get"}, &stdout, &stderr))
+	assert.Contains(t, stderr.String(), `unknown command group "widget"`)
+
+	stderr.Reset()
+	assert.Equal(t, 2, run(context.Background(), []string{"vault", "rename"}, &stdout, &stderr))
+	assert.Contains(t, stderr.String(), `unknown command "vault rename"`)
+
+	stderr.Reset()
+	assert.Equal(t, 2, run(context.Background(), []string{"vault", "get"}, &stdout, &stderr))
+	assert.Contains(t, stderr.String(), "Usage: cosconfig vault get <vault>")
+
+	stderr.Reset()
+	assert.Equal(t, 0, run(context.Background(), []string{"help"}, &stdout, &stderr))
+	assert.Empty(t, stdout.String())
+}
+
+func TestVaultGet(t *testing.T) {
+	server := newTestServer(t, func(res http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/backup_vaults/my-vault", req.URL.Path)
+		res.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(res, `{"backup_vault_name": "my-vault", "region": "us-south", "bytes_used": 42}`)
+	})
+
+	code, stdout, stderr := runCommand(server, "vault", "get", "my-vault")
+	assert.Equal(t, 0, code, stderr)
+
+	var vault map[string]interface{}
+	assert.Nil(t, json.Unmarshal([]byte(stdout), &vault))
+	assert.Equal(t, "my-vault", vault["backup_vault_name"])
+	assert.Equal(t, float64(42), vault["bytes_used"])
+}
+
+func TestVaultListPages(t *testing.T) {
+	server := newTestServer(t, func(res http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "/backup_vaults", req.URL.Path)
+		assert.Equal(t, "instance", req.URL.Query().Get("service_instance_id"))
+		res.Header().Set("Content-Type", "application/json")
+		if req.URL.Query().Get("token") == "" {
+			fmt.Fprint(res, `{"next": {"href": "next", "token": "page2"}, "backup_vaults": ["a"]}`)
+		} else {
+			fmt.Fprint(res, `{"backup_vaults": ["b"]}`)
+		}
+	})
+
+	code, stdout, stderr := runCommand(server, "vault", "list", "--instance-id", "instance")
+	assert.Equal(t, 0, code, stderr)
+
+	var vaults []string
+	assert.Nil(t, json.Unmarshal([]byte(stdout), &vaults))
+	assert.Equal(t, []string{"a", "b"}, vaults)
+}
+
+func TestVaultListRequiresInstance(t *testing.T) {
+	t.Setenv(envServiceInstanceID, "")
+	server := newTestServer(t, func(res http.ResponseWriter, req *http.Request) {
+		t.Error("unexpected request")
+	})
+
+	code, _, stderr := runCommand(server, "vault", "list")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "service instance id is required")
+}
+
+func TestProfile(t *testing.T) {
+	t.Setenv(envServiceInstanceID, "")
+	t.Setenv("TEST_COSCONFIG_API_KEY", "key")
+	server := newTestServer(t, func(res http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "/backup_vaults", req.URL.Path)
+		assert.Equal(t, "profile-instance", req.URL.Query().Get("service_instance_id"))
+		res.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(res, `{"backup_vaults": ["a"]}`)
+	})
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+	config := fmt.Sprintf("profiles:\n  test:\n    apikey_env: TEST_COSCONFIG_API_KEY\n    iam_url: %s\n    url: %s\n    service_instance_id: profile-instance\n", server.URL, server.URL)
+	assert.Nil(t, os.WriteFile(configPath, []byte(config), 0600))
+	t.Setenv(rc.ProfileConfigFileEnv, configPath)
+
+	var stdout, stderr bytes.Buffer
+	code := run(context.Background(), []string{"vault", "list", "--profile", "test"}, &stdout, &stderr)
+	assert.Equal(t, 0, code, stderr.String())
+	assert.JSONEq(t, `["a"]`, stdout.String())
+
+	stderr.Reset()
+	code = run(context.Background(), []string{"vault", "list", "--profile", "prod"}, &stdout, &stderr)
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr.String(), `profile "prod" not found`)
+}
+
+func TestProfileIAMURL(t *testing.T) {
+	t.Setenv(envServiceInstanceID, "")
+	t.Setenv("TEST_COSCONFIG_API_KEY", "key")
+	server := newTestServer(t, func(res http.ResponseWriter, req *http.Request) {
+		res.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(res, `{"backup_vaults": ["a"]}`)
+	})
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+	config := fmt.Sprintf("profiles:\n  test:\n    apikey_env: TEST_COSCONFIG_API_KEY\n    iam_url: http://127.0.0.1:1\n    url: %s\n    service_instance_id: profile-instance\n", server.URL)
+	assert.Nil(t, os.WriteFile(configPath, []byte(config), 0600))
+	t.Setenv(rc.ProfileConfigFileEnv, configPath)
+	t.Setenv(rc.ProfileEnv, "test")
+
+	var stdout, stderr bytes.Buffer
+	code := run(context.Background(), []string{"vault", "list"}, &stdout, &stderr)
+	assert.Equal(t, 1, code)
+
+	stdout.Reset()
+	stderr.Reset()
+	code = run(context.Background(), []string{"vault", "list", "--iam-url", server.URL}, &stdout, &stderr)
+	assert.Equal(t, 0, code, stderr.String())
+	assert.JSONEq(t, `["a"]`, stdout.String())
+}
+
+func TestEndpointFlags(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	code := run(context.Background(), []string{"vault", "get", "my-vault", "--apikey", "key", "--url", "http://localhost", "--endpoint-type", "private"}, &stdout, &stderr)
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr.String(), "--url cannot be combined with --endpoint-region or --endpoint-type")
+
+	stderr.Reset()
+	code = run(context.Background(), []string{"vault", "get", "my-vault", "--apikey", "key", "--endpoint-region", "us-north"}, &stdout, &stderr)
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr.String(), `region "us-north" is not supported`)
+
+	c := &cli{global: globalOptions{region: "eu-de", endpointType: "direct"}}
+	url, err := c.endpointURL()
+	assert.Nil(t, err)
+	assert.Equal(t, "https://config.direct.eu-de.cloud-object-storage.cloud.ibm.com/v1", url)
+}
+
+func TestBucketUpdate(t *testing.T) {
+	server := newTestServer(t, func(res http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "PATCH", req.Method)
+		assert.Equal(t, "/b/my-bucket", req.URL.Path)
+		assert.Equal(t, "etag", req.Header.Get("If-Match"))
+		body, _ := io.ReadAll(req.Body)
+		assert.JSONEq(t, `{"hard_quota": 100}`, string(body))
+		res.WriteHeader(http.StatusNoContent)
+	})
+
+	code, stdout, stderr := runCommand(server, "bucket", "update", "my-bucket", "--patch", `{"hard_quota": 100}`, "--if-match", "etag")
+	assert.Equal(t, 0, code, stderr)
+	assert.Equal(t, "Updated bucket my-bucket\n", stdout)
+
+	code, _, stderr = runCommand(server, "bucket", "update", "my-bucket")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "exactly one of --patch or --patch-file is required")
+}
+
+func TestPolicyCreate(t *testing.T) {
+	server := newTestServer(t, func(res http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "/buckets/my-bucket/backup_policies", req.URL.Path)
+		body, _ := io.ReadAll(req.Body)
+		assert.JSONEq(t, `{"initial_retention": {"delete_after_days": 30}, "policy_name": "daily", "target_backup_vault_crn": "crn:vault", "backup_type": "continuous"}`, string(body))
+		res.Header().Set("Content-Type", "application/json")
+		res.WriteHeader(http.StatusCreated)
+		fmt.Fprint(res, `{"policy_id": "p1", "policy_name": "daily", "policy_status": "pending"}`)
+	})
+
+	code, stdout, stderr := runCommand(server, "policy", "create", "my-bucket", "--name", "daily", "--vault-crn", "crn:vault", "--retention-days", "30")
+	assert.Equal(t, 0, code, stderr)
+	assert.Contains(t, stdout, `"policy_id": "p1"`)
+}
+
+func TestRestoreWait(t *testing.T) {
+	var polls int32
+	server := newTestServer(t, func(res http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "/backup_vaults/my-vault/restores/r1", req.URL.Path)
+		res.Header().Set("Content-Type", "application/json")
+		if atomic.AddInt32(&polls, 1) < 3 {
+			fmt.Fprint(res, `{"restore_id": "r1", "restore_status": "running", "restore_percent_progress": 50}`)
+		} else {
+			fmt.Fprint(res, `{"restore_id": "r1", "restore_status": "complete"}`)
+		}
+	})
+
+	code, stdout, stderr := runCommand(server, "restore", "wait", "my-vault", "r1", "--interval", "1ms")
+	assert.Equal(t, 0, code, stderr)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&polls))
+	assert.Equal(t, 2, strings.Count(stderr, "restore r1: running (50%)"))
+	assert.Contains(t, stdout, `"restore_status": "complete"`)
+}
+
+func TestServiceError(t *testing.T) {
+	server := newTestServer(t, func(res http.ResponseWriter, req *http.Request) {
+		res.Header().Set("Content-Type", "application/json")
+		res.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(res, `{"errors": [{"code": "not_found", "message": "The backup vault does not exist"}]}`)
+	})
+
+	code, stdout, stderr := runCommand(server, "restore", "get", "my-vault", "r1")
+	assert.Equal(t, 1, code)
+	assert.Empty(t, stdout)
+	assert.Contains(t, stderr, "The backup vault does not exist")
+}
+
+func TestReportCoverage(t *testing.T) {
+	server := newTestServer(t, func(res http.ResponseWriter, req *http.Request) {
+		res.Header().Set("Content-Type", "application/json")
+		switch req.URL.Path {
+		case "/b/my-bucket":
+			fmt.Fprint(res, `{"name": "my-bucket", "crn": "crn:v1:bluemix:public:cloud-object-storage:global:a/acct:inst:bucket:my-bucket"}`)
+		case "/buckets/my-bucket/backup_policies":
+			fmt.Fprint(res, `{"backup_policies": []}`)
+		default:
+			t.Errorf("unexpected request %s", req.URL.Path)
+		}
+	})
+
+	code, stdout, stderr := runCommand(server, "report", "coverage", "my-bucket", "-o", "table", "--stale-after", "2h")
+	assert.Equal(t, 0, code, stderr)
+	lines := strings.Split(strings.TrimSpace(stdout), "\n")
+	assert.Len(t, lines, 2)
+	assert.True(t, strings.HasPrefix(lines[0], "BUCKET"))
+	assert.Regexp(t, `^my-bucket\s+-.*unprotected$`, lines[1])
+
+	code, stdout, stderr = runCommand(server, "report", "coverage", "my-bucket")
+	assert.Equal(t, 0, code, stderr)
+	var report rc.CoverageReport
+	assert.Nil(t, json.Unmarshal([]byte(stdout), &report))
+	assert.Equal(t, []string{rc.CoverageFlag_Unprotected}, report.Buckets[0].Flags)
+
+	code, _, stderr = runCommand(server, "report", "coverage")
+	assert.Equal(t, 2, code)
+	assert.Contains(t, stderr, "expected at least 1 argument")
+}
+
+func TestRestoreResolve(t *testing.T) {
+	server := newTestServer(t, func(res http.ResponseWriter, req *http.Request) {
+		res.Header().Set("Content-Type", "application/json")
+		switch req.URL.Path {
+		case "/backup_vaults":
+			assert.Equal(t, "instance", req.URL.Query().Get("service_instance_id"))
+			fmt.Fprint(res, `{"backup_vaults": ["my-vault"]}`)
+		case "/backup_vaults/my-vault/recovery_ranges":
+			assert.Equal(t, "bucket-crn", req.URL.Query().Get("source_resource_crn"))
+			fmt.Fprint(res, `{"recovery_ranges": [{"recovery_range_id": "r1", "source_resource_crn": "bucket-crn", "range_start_time": "2025-01-01T00:00:00Z", "range_end_time": "2025-02-01T00:00:00Z"}]}`)
+		default:
+			t.Errorf("unexpected request %s", req.URL.Path)
+		}
+	})
+
+	code, stdout, stderr := runCommand(server, "restore", "resolve", "bucket-crn", "--instance-id", "instance", "--point-in-time", "2025-01-15T00:00:00Z", "-o", "table")
+	assert.Equal(t, 0, code, stderr)
+	lines := strings.Split(strings.TrimSpace(stdout), "\n")
+	assert.Len(t, lines, 2)
+	assert.Regexp(t, `^VAULT\s+ID\s+SOURCE`, lines[0])
+	assert.Regexp(t, `^my-vault\s+r1\s+bucket-crn`, lines[1])
+
+	code, _, stderr = runCommand(server, "restore", "resolve", "bucket-crn", "--instance-id", "instance")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "--point-in-time is required")
+}
+
+func TestRestoreBulk(t *testing.T) {
+	server := newTestServer(t, func(res http.ResponseWriter, req *http.Request) {
+		res.Header().Set("Content-Type", "application/json")
+		switch req.URL.Path {
+		case "/backup_vaults":
+			fmt.Fprint(res, `{"backup_vaults": ["my-vault"]}`)
+		case "/backup_vaults/my-vault/recovery_ranges":
+			if req.URL.Query().Get("source_resource_crn") == "missing-crn" {
+				fmt.Fprint(res, `{"recovery_ranges": []}`)
+				return
+			}
+			fmt.Fprint(res, `{"recovery_ranges": [{"recovery_range_id": "r1", "range_start_time": "2025-01-01T00:00:00Z", "range_end_time": "2025-02-01T00:00:00Z"}]}`)
+		case "/backup_vaults/my-vault/restores":
+			assert.Equal(t, http.MethodPost, req.Method)
+			res.WriteHeader(http.StatusCreated)
+			fmt.Fprint(res, `{"restore_id": "restore-1", "restore_status": "initializing"}`)
+		case "/backup_vaults/my-vault/restores/restore-1":
+			fmt.Fprint(res, `{"restore_id": "restore-1", "restore_status": "complete", "restore_percent_progress": 100}`)
+		default:
+			t.Errorf("unexpected request %s", req.URL.Path)
+		}
+	})
+	listPath := filepath.Join(t.TempDir(), "restores.yaml")
+	list := `
+- source_resource_crn: bucket-crn
+  target_resource_crn: target-crn
+  point_in_time: "2025-01-15T00:00:00Z"
+- source_resource_crn: missing-crn
+  target_resource_crn: target-crn
+  point_in_time: "2025-01-15T00:00:00Z"
+`
+	assert.NoError(t, os.WriteFile(listPath, []byte(list), 0o600))
+
+	code, stdout, stderr := runCommand(server, "restore", "bulk", listPath, "--instance-id", "instance", "--interval", "1ms", "--concurrency", "1", "-o", "table")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "[1] bucket-crn: started")
+	assert.Contains(t, stderr, "[1] bucket-crn: progress (100%)")
+	assert.Contains(t, stderr, "[2] missing-crn: failed: no recovery range")
+	assert.Contains(t, stderr, "1 of 2 restores did not complete")
+	lines := strings.Split(strings.TrimSpace(stdout), "\n")
+	assert.Len(t, lines, 3)
+	assert.Regexp(t, `^bucket-crn\s+target-crn\s+complete\s+my-vault\s+r1\s+restore-1`, lines[1])
+	assert.Regexp(t, `^missing-crn\s+target-crn\s+failed`, lines[2])
+
+	code, _, stderr = runCommand(server, "restore", "bulk", filepath.Join(t.TempDir(), "missing.yaml"), "--instance-id", "instance")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "no such file")
+}
+
+func TestRangeApplyRules(t *testing.T) {
+	var patched int32
+	server := newTestServer(t, func(res http.ResponseWriter, req *http.Request) {
+		res.Header().Set("Content-Type", "application/json")
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/backup_vaults/my-vault/recovery_ranges":
+			fmt.Fprint(res, `{"recovery_ranges": [{"recovery_range_id": "r1", "source_resource_crn": "crn:v1:bluemix:public:cloud-object-storage:global:a/acct:inst:bucket:prod-a", "retention": {"delete_after_days": 30}}]}`)
+		case req.Method == http.MethodPatch && req.URL.Path == "/backup_vaults/my-vault/recovery_ranges/r1":
+			atomic.AddInt32(&patched, 1)
+			fmt.Fprint(res, `{"recovery_range_id": "r1", "retention": {"delete_after_days": 90}}`)
+		default:
+			t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+		}
+	})
+	rulesPath := filepath.Join(t.TempDir(), "rules.yaml")
+	rules := `
+- name: prod-minimum
+  bucket_pattern: prod-*
+  min_delete_after_days: 90
+`
+	assert.NoError(t, os.WriteFile(rulesPath, []byte(rules), 0o600))
+
+	code, stdout, stderr := runCommand(server, "range", "apply-rules", "my-vault", "--rules", rulesPath, "--dry-run", "-o", "table")
+	assert.Equal(t, 0, code, stderr)
+	assert.Regexp(t, `r1\s+\S+bucket:prod-a\s+30\s+90\s+false\s+prod-minimum\s+dry run`, stdout)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&patched))
+
+	code, stdout, stderr = runCommand(server, "range", "apply-rules", "my-vault", "--rules", rulesPath)
+	assert.Equal(t, 0, code, stderr)
+	assert.Contains(t, stdout, `"applied": true`)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&patched))
+
+	code, _, stderr = runCommand(server, "range", "apply-rules", "my-vault")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "--rules is required")
+}
+
+func TestReportExpiry(t *testing.T) {
+	server := newTestServer(t, func(res http.ResponseWriter, req *http.Request) {
+		res.Header().Set("Content-Type", "application/json")
+		switch req.URL.Path {
+		case "/backup_vaults":
+			fmt.Fprint(res, `{"backup_vaults": ["my-vault"]}`)
+		case "/backup_vaults/my-vault/recovery_ranges":
+			end := time.Now().UTC().Add(-5 * 24 * time.Hour).Format(time.RFC3339)
+			fmt.Fprintf(res, `{"recovery_ranges": [
+				{"recovery_range_id": "soon", "range_start_time": "2025-01-01T00:00:00Z", "range_end_time": "%[1]s", "retention": {"delete_after_days": 7}},
+				{"recovery_range_id": "later", "range_start_time": "2025-01-01T00:00:00Z", "range_end_time": "%[1]s", "retention": {"delete_after_days": 365}}
+			]}`, end)
+		default:
+			t.Errorf("unexpected request %s", req.URL.Path)
+		}
+	})
+
+	code, stdout, stderr := runCommand(server, "report", "expiry", "--instance-id", "instance", "--days", "10", "-o", "table")
+	assert.Equal(t, 0, code, stderr)
+	lines := strings.Split(strings.TrimSpace(stdout), "\n")
+	assert.Len(t, lines, 2)
+	assert.Regexp(t, `^EXPIRES\s+IN\s+VAULT\s+RANGE`, lines[0])
+	assert.Regexp(t, `\s+my-vault\s+soon\s+`, lines[1])
+
+	code, _, stderr = runCommand(server, "report", "expiry", "--instance-id", "instance", "--days", "0")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "--days must be at least 1")
+}
+
+func TestReportUsage(t *testing.T) {
+	server := newTestServer(t, func(res http.ResponseWriter, req *http.Request) {
+		res.Header().Set("Content-Type", "application/json")
+		switch req.URL.Path {
+		case "/backup_vaults":
+			assert.Equal(t, "instance", req.URL.Query().Get("service_instance_id"))
+			fmt.Fprint(res, `{"backup_vaults": ["my-vault"]}`)
+		case "/backup_vaults/my-vault":
+			fmt.Fprint(res, `{"backup_vault_name": "my-vault", "region": "us-south", "bytes_used": 5000000000}`)
+		case "/backup_vaults/my-vault/recovery_ranges":
+			fmt.Fprint(res, `{"recovery_ranges": []}`)
+		default:
+			t.Errorf("unexpected request %s", req.URL.Path)
+		}
+	})
+	pricesPath := filepath.Join(t.TempDir(), "prices.yaml")
+	prices := `
+currency: USD
+price_per_gb_month:
+  us-south: 0.02
+`
+	assert.NoError(t, os.WriteFile(pricesPath, []byte(prices), 0o600))
+
+	code, stdout, stderr := runCommand(server, "report", "usage", "instance", "--prices", pricesPath, "-o", "csv")
+	assert.Equal(t, 0, code, stderr)
+	assert.Equal(t, "service_instance_id,region,backup_vault_name,bytes_used,protected_buckets,protected_bytes,ratio,monthly_cost,currency,error\n"+
+		"instance,us-south,my-vault,5000000000,0,0,,0.10,USD,\n", stdout)
+
+	code, stdout, stderr = runCommand(server, "report", "usage", "instance", "-o", "table")
+	assert.Equal(t, 0, code, stderr)
+	assert.Regexp(t, `instance\s+us-south\s+1\s+5000000000\s+0\s+-`, stdout)
+
+	code, _, stderr = runCommand(server, "report", "usage")
+	assert.Equal(t, 2, code)
+	assert.Contains(t, stderr, "expected at least 1 argument")
+}
+
+func TestVaultDelete(t *testing.T) {
+	var deleted []string
+	ranges := `[{"recovery_range_id": "r1"}]`
+	server := newTestServer(t, func(res http.ResponseWriter, req *http.Request) {
+		res.Header().Set("Content-Type", "application/json")
+		if req.Method == http.MethodDelete {
+			deleted = append(deleted, req.URL.Path)
+			res.WriteHeader(http.StatusNoContent)
+			return
+		}
+		switch req.URL.Path {
+		case "/backup_vaults/my-vault/recovery_ranges":
+			fmt.Fprintf(res, `{"recovery_ranges": %s}`, ranges)
+		case "/backup_vaults/my-vault/restores":
+			fmt.Fprint(res, `{"restores": []}`)
+		case "/buckets/my-bucket/backup_policies":
+			fmt.Fprint(res, `{"backup_policies": [{"policy_id": "p1", "target_backup_vault_crn": "crn:v1:bluemix:public:cloud-object-storage:global:a/account:instance:backup-vault:my-vault"}]}`)
+		default:
+			t.Errorf("unexpected request %s", req.URL.Path)
+		}
+	})
+
+	code, _, stderr := runCommand(server, "vault", "delete", "my-vault", "--buckets", "my-bucket")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "has 1 recovery ranges, 0 restores in progress and 1 backup policies")
+	assert.Empty(t, deleted)
+
+	code, _, stderr = runCommand(server, "vault", "delete", "my-vault", "--buckets", "my-bucket", "--cascade")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "confirmation token")
+	assert.Empty(t, deleted)
+
+	code, _, stderr = runCommand(server, "vault", "delete", "my-vault", "--buckets", "my-bucket", "--cascade", "--confirm", "my-vault")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "recovery ranges cannot be deleted")
+	assert.Empty(t, deleted)
+
+	ranges = `[]`
+	code, stdout, stderr := runCommand(server, "vault", "delete", "my-vault", "--buckets", "my-bucket", "--cascade", "--confirm", "my-vault")
+	assert.Equal(t, 0, code, stderr)
+	assert.Equal(t, "Deleted backup policy p1 of bucket my-bucket\nDeleted backup vault my-vault\n", stdout)
+	assert.Equal(t, []string{"/buckets/my-bucket/backup_policies/p1", "/backup_vaults/my-vault"}, deleted)
+}
+
+func TestPolicyMigrate(t *testing.T) {
+	const vaultCrnPrefix = "crn:v1:bluemix:public:cloud-object-storage:global:a/account:instance:backup-vault:"
+	var deleted []string
+	server := newTestServer(t, func(res http.ResponseWriter, req *http.Request) {
+		res.Header().Set("Content-Type", "application/json")
+		switch {
+		case req.Method == http.MethodDelete:
+			deleted = append(deleted, req.URL.Path)
+			res.WriteHeader(http.StatusNoContent)
+		case req.Method == http.MethodPost:
+			res.WriteHeader(http.StatusCreated)
+			fmt.Fprint(res, `{"policy_id": "new", "policy_status": "pending"}`)
+		case req.URL.Path == "/buckets/my-bucket/backup_policies":
+			fmt.Fprintf(res, `{"backup_policies": [{"policy_id": "old", "policy_name": "daily", "target_backup_vault_crn": "%sold-vault"}]}`, vaultCrnPrefix)
+		case req.URL.Path == "/buckets/my-bucket/backup_policies/old":
+			fmt.Fprintf(res, `{"policy_id": "old", "policy_name": "daily", "target_backup_vault_crn": "%sold-vault", "initial_retention": {"delete_after_days": 30}}`, vaultCrnPrefix)
+		case req.URL.Path == "/buckets/my-bucket/backup_policies/new":
+			fmt.Fprint(res, `{"policy_id": "new", "policy_status": "active"}`)
+		case req.URL.Path == "/backup_vaults/new-vault":
+			fmt.Fprint(res, `{"backup_vault_name": "new-vault"}`)
+		default:
+			t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+		}
+	})
+
+	code, stdout, stderr := runCommand(server, "policy", "migrate", "my-bucket", "old", "--vault-crn", vaultCrnPrefix+"new-vault", "-o", "table")
+	assert.Equal(t, 0, code, stderr)
+	assert.Contains(t, stderr, "backup policy new: active")
+	lines := strings.Split(strings.TrimSpace(stdout), "\n")
+	assert.Len(t, lines, 5)
+	assert.Regexp(t, `^create_policy\s+\S+\s+created backup policy daily-new-vault \(new\)`, lines[2])
+	assert.Equal(t, []string{"/buckets/my-bucket/backup_policies/old"}, deleted)
+
+	code, _, stderr = runCommand(server, "policy", "migrate", "my-bucket", "old")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "--vault-crn is required")
+}
+
+func TestMonitorRun(t *testing.T) {
+	server := newTestServer(t, func(res http.ResponseWriter, req *http.Request) {
+		res.Header().Set("Content-Type", "application/json")
+		switch req.URL.Path {
+		case "/buckets/my-bucket/backup_policies":
+			fmt.Fprint(res, `{"backup_policies": [{"policy_id": "p1", "policy_status": "degraded", "error_cause": "key deleted"}]}`)
+		case "/backup_vaults/my-vault/restores":
+			res.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(res, `{"errors": [{"code": "not_found", "message": "The backup vault does not exist"}]}`)
+		default:
+			t.Errorf("unexpected request %s", req.URL.Path)
+		}
+	})
+
+	code, stdout, stderr := runCommand(server, "monitor", "run", "--buckets", "my-bucket", "--vaults", "my-vault", "--once")
+	assert.Equal(t, 0, code, stderr)
+	assert.Regexp(t, `^\S+ FIRING backup policy p1 of bucket my-bucket is degraded: key deleted\n$`, stdout)
+	assert.Contains(t, stderr, "The backup vault does not exist")
+
+	code, _, stderr = runCommand(server, "monitor", "run", "--once")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "at least one of --buckets and --vaults is required")
+}
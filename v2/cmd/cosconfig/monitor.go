@@ -0,0 +1,75 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	rc "github.com/IBM/ibm-cos-sdk-go-config/v2/resourceconfigurationv1"
+)
+
+func monitorRun(c *cli, args []string) error {
+	fs := c.flagSet("monitor run")
+	buckets := fs.String("buckets", "", "comma-separated buckets whose backup policies are watched")
+	vaults := fs.String("vaults", "", "comma-separated backup vaults whose restores are watched")
+	interval := fs.Duration("interval", rc.DefaultMonitorInterval, "time between checks")
+	webhook := fs.String("webhook", "", "URL to post every alert to as JSON")
+	once := fs.Bool("once", false, "check once and exit")
+	if _, err := c.parse(fs, args, 0); err != nil {
+		return err
+	}
+	if *buckets == "" && *vaults == "" {
+		return fmt.Errorf("at least one of --buckets and --vaults is required")
+	}
+
+	service, err := c.service()
+	if err != nil {
+		return err
+	}
+	sinks := []rc.AlertSink{
+		rc.AlertSinkFunc(func(_ context.Context, alert *rc.Alert) error {
+			fmt.Fprintf(c.stdout, "%s %s %s\n", time.Time(*alert.DetectedAt).Format(time.RFC3339), strings.ToUpper(alert.State), alert.String())
+			return nil
+		}),
+	}
+	if *webhook != "" {
+		sinks = append(sinks, rc.NewWebhookAlertSink(*webhook))
+	}
+	options := service.NewMonitorOptions(sinks...)
+	if *buckets != "" {
+		options.SetBuckets(strings.Split(*buckets, ","))
+	}
+	if *vaults != "" {
+		options.SetBackupVaults(strings.Split(*vaults, ","))
+	}
+	options.SetInterval(*interval)
+	options.SetOnError(func(err error) {
+		fmt.Fprintf(c.stderr, "cosconfig: %s\n", err.Error())
+	})
+	monitor, err := service.NewMonitor(options)
+	if err != nil {
+		return err
+	}
+	if *once {
+		monitor.Check(c.ctx)
+		return nil
+	}
+	return monitor.Run(c.ctx)
+}
@@ -0,0 +1,190 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/template"
+
+	"sigs.k8s.io/yaml"
+)
+
+// Output formats accepted by --output.
+const (
+	outputTable      = "table"
+	outputJSON       = "json"
+	outputYAML       = "yaml"
+	outputCSV        = "csv"
+	outputJSONPath   = "jsonpath="
+	outputGoTemplate = "go-template="
+)
+
+// printer renders command results in the format chosen with --output.
+type printer interface {
+	print(w io.Writer, result interface{}) error
+}
+
+// newPrinter returns the printer for the --output value "format".
+func newPrinter(format string) (printer, error) {
+	switch {
+	case format == "" || format == outputJSON:
+		return jsonPrinter{}, nil
+	case format == outputYAML:
+		return yamlPrinter{}, nil
+	case format == outputTable:
+		return tablePrinter{}, nil
+	case format == outputCSV:
+		return csvPrinter{}, nil
+	case strings.HasPrefix(format, outputJSONPath):
+		path, err := parseJSONPath(strings.TrimPrefix(format, outputJSONPath))
+		if err != nil {
+			return nil, err
+		}
+		return jsonPathPrinter{path: path}, nil
+	case strings.HasPrefix(format, outputGoTemplate):
+		tpl, err := template.New("output").Parse(strings.TrimPrefix(format, outputGoTemplate))
+		if err != nil {
+			return nil, err
+		}
+		return templatePrinter{template: tpl}, nil
+	}
+	return nil, fmt.Errorf("unknown output format %q (use table, json, yaml, csv, jsonpath=<expr> or go-template=<template>)", format)
+}
+
+type jsonPrinter struct{}
+
+func (jsonPrinter) print(w io.Writer, result interface{}) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(result)
+}
+
+type yamlPrinter struct{}
+
+func (yamlPrinter) print(w io.Writer, result interface{}) error {
+	data, err := yaml.Marshal(result)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+// csvPrinter renders the results that can write themselves as CSV, such as reports.
+type csvPrinter struct{}
+
+func (csvPrinter) print(w io.Writer, result interface{}) error {
+	if report, ok := result.(interface{ WriteCSV(io.Writer) error }); ok {
+		return report.WriteCSV(w)
+	}
+	return fmt.Errorf("csv output is not supported by this command")
+}
+
+type jsonPathPrinter struct {
+	path *jsonPath
+}
+
+func (p jsonPathPrinter) print(w io.Writer, result interface{}) error {
+	data, err := toGeneric(result)
+	if err != nil {
+		return err
+	}
+	if err = p.path.execute(w, data); err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "\n")
+	return err
+}
+
+type templatePrinter struct {
+	template *template.Template
+}
+
+func (p templatePrinter) print(w io.Writer, result interface{}) error {
+	data, err := toGeneric(result)
+	if err != nil {
+		return err
+	}
+	return p.template.Execute(w, data)
+}
+
+// toGeneric converts a model to maps, slices and scalars keyed by the JSON property names, so that
+// templates and JSONPath expressions use the same names as the API and the JSON output.
+func toGeneric(result interface{}) (interface{}, error) {
+	data, err := json.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+	var generic interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err = decoder.Decode(&generic); err != nil {
+		return nil, err
+	}
+	return generic, nil
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// print writes "result" to standard output in the selected output format.
+func (c *cli) print(result interface{}) error {
+	return c.printer.print(c.stdout, result)
+}
+
+// printStatus writes a short confirmation message for operations that return no body.
+func (c *cli) printStatus(format string, args ...interface{}) {
+	fmt.Fprintf(c.stdout, format+"\n", args...)
+}
+
+// readPatch returns the JSON merge-patch given either inline or as a file name ("-" for standard input).
+func readPatch(stdin io.Reader, inline string, file string) (map[string]interface{}, error) {
+	if (inline == "") == (file == "") {
+		return nil, fmt.Errorf("exactly one of --patch or --patch-file is required")
+	}
+
+	data := []byte(inline)
+	if file != "" {
+		var err error
+		if file == "-" {
+			data, err = io.ReadAll(stdin)
+		} else {
+			data, err = os.ReadFile(file)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	patch := make(map[string]interface{})
+	if err := json.Unmarshal(data, &patch); err != nil {
+		return nil, fmt.Errorf("invalid patch document: %s", err.Error())
+	}
+	return patch, nil
+}
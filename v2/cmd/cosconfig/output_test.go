@@ -0,0 +1,151 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+
+	rc "github.com/IBM/ibm-cos-sdk-go-config/v2/resourceconfigurationv1"
+)
+
+func testPolicies() *rc.BackupPolicyCollection {
+	return &rc.BackupPolicyCollection{
+		BackupPolicies: []rc.BackupPolicy{
+			{
+				PolicyID:             core.StringPtr("p1"),
+				PolicyName:           core.StringPtr("daily"),
+				PolicyStatus:         core.StringPtr("initializing"),
+				InitialSyncProgress:  core.Float64Ptr(42.5),
+				InitialRetention:     &rc.DeleteAfterDays{DeleteAfterDays: core.Int64Ptr(30)},
+				TargetBackupVaultCrn: core.StringPtr("crn:vault"),
+			},
+			{
+				PolicyID:     core.StringPtr("p2"),
+				PolicyName:   core.StringPtr("weekly"),
+				PolicyStatus: core.StringPtr("active"),
+			},
+		},
+	}
+}
+
+func render(t *testing.T, format string, result interface{}) string {
+	p, err := newPrinter(format)
+	assert.Nil(t, err)
+	var buf bytes.Buffer
+	assert.Nil(t, p.print(&buf, result))
+	return buf.String()
+}
+
+func TestNewPrinter(t *testing.T) {
+	for _, format := range []string{"", "json", "yaml", "table", "csv", "jsonpath={.a}", "go-template={{.a}}"} {
+		_, err := newPrinter(format)
+		assert.Nil(t, err, format)
+	}
+	for _, format := range []string{"xml", "jsonpath={.a", "jsonpath={range .a}", "go-template={{.a"} {
+		_, err := newPrinter(format)
+		assert.NotNil(t, err, format)
+	}
+}
+
+func TestYAMLOutput(t *testing.T) {
+	out := render(t, "yaml", testPolicies())
+	assert.Contains(t, out, "backup_policies:\n")
+	assert.Contains(t, out, "policy_name: daily\n")
+	assert.Contains(t, out, "delete_after_days: 30\n")
+}
+
+func TestJSONPathOutput(t *testing.T) {
+	policies := testPolicies()
+	assert.Equal(t, "p1 p2\n", render(t, "jsonpath={.backup_policies[*].policy_id}", policies))
+	assert.Equal(t, "weekly\n", render(t, "jsonpath={.backup_policies[-1].policy_name}", policies))
+	assert.Equal(t, "30\n", render(t, "jsonpath={.backup_policies[0].initial_retention.delete_after_days}", policies))
+	assert.Equal(t, "\n", render(t, "jsonpath={.backup_policies[5].policy_id}", policies))
+	assert.Equal(t, "p1=initializing\np2=active\n\n",
+		render(t, `jsonpath={range .backup_policies[*]}{.policy_id}={.policy_status}{"\n"}{end}`, policies))
+	assert.Equal(t, "name: daily\n", render(t, "jsonpath=name: {.backup_policies[0]['policy_name']}", policies))
+}
+
+func TestGoTemplateOutput(t *testing.T) {
+	out := render(t, `go-template={{range .backup_policies}}{{.policy_id}} {{.policy_status}}{{"\n"}}{{end}}`, testPolicies())
+	assert.Equal(t, "p1 initializing\np2 active\n", out)
+}
+
+func TestTableOutput(t *testing.T) {
+	out := render(t, "table", testPolicies())
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	assert.Len(t, lines, 3)
+	assert.Equal(t, []string{"ID", "NAME", "STATUS", "INITIAL", "SYNC", "RETENTION", "DAYS", "TARGET", "VAULT"}, strings.Fields(lines[0]))
+	assert.Equal(t, []string{"p1", "daily", "initializing", "42.5%", "30", "crn:vault"}, strings.Fields(lines[1]))
+	assert.Equal(t, []string{"p2", "weekly", "active", "-", "-", "-"}, strings.Fields(lines[2]))
+
+	pointInTime := strfmtDateTime(t, "2025-01-02T03:04:05Z")
+	out = render(t, "table", []rc.Restore{{
+		RestoreID:              core.StringPtr("r1"),
+		RestoreStatus:          core.StringPtr("running"),
+		RestorePointInTime:     pointInTime,
+		InitTime:               pointInTime,
+		TargetResourceCrn:      core.StringPtr("crn:bucket"),
+		RestorePercentProgress: core.Int64Ptr(75),
+	}})
+	assert.Contains(t, out, "r1   running   75%        2025-01-02T03:04:05Z   2025-01-02T03:04:05Z   -           crn:bucket")
+
+	out = render(t, "table", []rc.RecoveryRange{{
+		RecoveryRangeID: core.StringPtr("rr1"),
+		Retention:       &rc.DeleteAfterDaysWithIndefinite{DeleteAfterDays: core.Int64Ptr(-1)},
+	}})
+	assert.Contains(t, out, "indefinite")
+
+	p, _ := newPrinter("table")
+	assert.NotNil(t, p.print(&bytes.Buffer{}, map[string]string{}))
+}
+
+func TestOutputFlag(t *testing.T) {
+	server := newTestServer(t, func(res http.ResponseWriter, req *http.Request) {
+		res.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(res, `{"backup_vault_name": "my-vault", "region": "us-south", "bytes_used": 42}`)
+	})
+
+	code, stdout, stderr := runCommand(server, "-o", "table", "vault", "get", "my-vault")
+	assert.Equal(t, 0, code, stderr)
+	assert.Contains(t, stdout, "my-vault   us-south   42")
+
+	code, stdout, stderr = runCommand(server, "vault", "get", "my-vault", "--output", "jsonpath={.region}")
+	assert.Equal(t, 0, code, stderr)
+	assert.Equal(t, "us-south\n", stdout)
+
+	code, _, stderr = runCommand(server, "vault", "get", "my-vault", "--output", "xml")
+	assert.Equal(t, 2, code)
+	assert.Contains(t, stderr, `unknown output format "xml"`)
+
+	code, _, stderr = runCommand(server, "vault", "get", "my-vault", "--output", "csv")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "csv output is not supported by this command")
+}
+
+func strfmtDateTime(t *testing.T, value string) *strfmt.DateTime {
+	dateTime, err := core.ParseDateTime(value)
+	assert.Nil(t, err)
+	return &dateTime
+}
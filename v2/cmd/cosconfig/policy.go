@@ -0,0 +1,146 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	rc "github.com/IBM/ibm-cos-sdk-go-config/v2/resourceconfigurationv1"
+)
+
+func policyList(c *cli, args []string) error {
+	fs := c.flagSet("policy list")
+	args, err := c.parse(fs, args, 1)
+	if err != nil {
+		return err
+	}
+
+	service, err := c.service()
+	if err != nil {
+		return err
+	}
+	policies, _, err := service.ListBackupPoliciesWithContext(c.ctx, service.NewListBackupPoliciesOptions(args[0]))
+	if err != nil {
+		return err
+	}
+	return c.print(policies)
+}
+
+func policyCreate(c *cli, args []string) error {
+	fs := c.flagSet("policy create")
+	name := fs.String("name", "", "name of the backup policy")
+	vaultCrn := fs.String("vault-crn", "", "CRN of the backup vault to back up to")
+	retentionDays := fs.Int64("retention-days", 0, "number of days to retain data in the recovery range")
+	backupType := fs.String("backup-type", rc.CreateBackupPolicyOptions_BackupType_Continuous, "type of backup")
+	args, err := c.parse(fs, args, 1)
+	if err != nil {
+		return err
+	}
+	if *name == "" || *vaultCrn == "" || *retentionDays == 0 {
+		return fmt.Errorf("--name, --vault-crn and --retention-days are required")
+	}
+
+	service, err := c.service()
+	if err != nil {
+		return err
+	}
+	retention := &rc.DeleteAfterDays{DeleteAfterDays: core.Int64Ptr(*retentionDays)}
+	options := service.NewCreateBackupPolicyOptions(args[0], retention, *name, *vaultCrn, *backupType)
+	policy, _, err := service.CreateBackupPolicyWithContext(c.ctx, options)
+	if err != nil {
+		return err
+	}
+	return c.print(policy)
+}
+
+func policyGet(c *cli, args []string) error {
+	fs := c.flagSet("policy get")
+	args, err := c.parse(fs, args, 2)
+	if err != nil {
+		return err
+	}
+
+	service, err := c.service()
+	if err != nil {
+		return err
+	}
+	policy, _, err := service.GetBackupPolicyWithContext(c.ctx, service.NewGetBackupPolicyOptions(args[0], args[1]))
+	if err != nil {
+		return err
+	}
+	return c.print(policy)
+}
+
+func policyDelete(c *cli, args []string) error {
+	fs := c.flagSet("policy delete")
+	args, err := c.parse(fs, args, 2)
+	if err != nil {
+		return err
+	}
+
+	service, err := c.service()
+	if err != nil {
+		return err
+	}
+	if _, err = service.DeleteBackupPolicyWithContext(c.ctx, service.NewDeleteBackupPolicyOptions(args[0], args[1])); err != nil {
+		return err
+	}
+	c.printStatus("Deleted backup policy %s from bucket %s", args[1], args[0])
+	return nil
+}
+
+func policyMigrate(c *cli, args []string) error {
+	fs := c.flagSet("policy migrate")
+	vaultCrn := fs.String("vault-crn", "", "CRN of the backup vault to migrate to")
+	name := fs.String("name", "", "name of the new backup policy (default <old-name>-<vault>)")
+	retentionDays := fs.Int64("retention-days", 0, "number of days to retain data (default the retention of the old policy)")
+	keepOld := fs.Bool("keep-old", false, "keep the old backup policy once the new policy is active")
+	interval := fs.Duration("interval", rc.DefaultWaitPollInterval, "time between status checks")
+	args, err := c.parse(fs, args, 2)
+	if err != nil {
+		return err
+	}
+	if *vaultCrn == "" {
+		return fmt.Errorf("--vault-crn is required")
+	}
+
+	service, err := c.service()
+	if err != nil {
+		return err
+	}
+	options := service.NewMigrateBackupPolicyOptions()
+	if *name != "" {
+		options.SetPolicyName(*name)
+	}
+	if *retentionDays != 0 {
+		options.SetInitialRetention(&rc.DeleteAfterDays{DeleteAfterDays: core.Int64Ptr(*retentionDays)})
+	}
+	options.SetKeepOldPolicy(*keepOld)
+	options.SetPollInterval(*interval)
+	options.SetOnPoll(func(policy *rc.BackupPolicy) {
+		fmt.Fprintf(c.stderr, "backup policy %s: %s\n", core.StringNilMapper(policy.PolicyID), core.StringNilMapper(policy.PolicyStatus))
+	})
+	migration, err := service.MigrateBackupPolicy(c.ctx, args[0], args[1], *vaultCrn, options)
+	if migration == nil {
+		return err
+	}
+	if printErr := c.print(migration); printErr != nil && err == nil {
+		err = printErr
+	}
+	return err
+}
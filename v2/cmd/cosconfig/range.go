@@ -0,0 +1,147 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	rc "github.com/IBM/ibm-cos-sdk-go-config/v2/resourceconfigurationv1"
+	"sigs.k8s.io/yaml"
+)
+
+func rangeList(c *cli, args []string) error {
+	fs := c.flagSet("range list")
+	sourceCrn := fs.String("source-crn", "", "only list ranges of the bucket with this CRN")
+	latest := fs.Bool("latest", false, "only list the latest range of each bucket")
+	args, err := c.parse(fs, args, 1)
+	if err != nil {
+		return err
+	}
+
+	service, err := c.service()
+	if err != nil {
+		return err
+	}
+	options := service.NewListRecoveryRangesOptions(args[0])
+	if *sourceCrn != "" {
+		options.SetSourceResourceCrn(*sourceCrn)
+	}
+	if *latest {
+		options.SetLatest("true")
+	}
+	pager, err := service.NewRecoveryRangesPager(options)
+	if err != nil {
+		return err
+	}
+	ranges, err := pager.GetAllWithContext(c.ctx)
+	if err != nil {
+		return err
+	}
+	if ranges == nil {
+		ranges = []rc.RecoveryRange{}
+	}
+	return c.print(ranges)
+}
+
+func rangeGet(c *cli, args []string) error {
+	fs := c.flagSet("range get")
+	args, err := c.parse(fs, args, 2)
+	if err != nil {
+		return err
+	}
+
+	service, err := c.service()
+	if err != nil {
+		return err
+	}
+	recoveryRange, _, err := service.GetSourceResourceRecoveryRangeWithContext(c.ctx, service.NewGetSourceResourceRecoveryRangeOptions(args[0], args[1]))
+	if err != nil {
+		return err
+	}
+	return c.print(recoveryRange)
+}
+
+func rangePatch(c *cli, args []string) error {
+	fs := c.flagSet("range patch")
+	retentionDays := fs.Int64("retention-days", 0, "new number of days to retain data in the recovery range")
+	args, err := c.parse(fs, args, 2)
+	if err != nil {
+		return err
+	}
+	if *retentionDays == 0 {
+		return fmt.Errorf("--retention-days is required")
+	}
+
+	rangePatch := &rc.RecoveryRangePatch{
+		Retention: &rc.DeleteAfterDays{DeleteAfterDays: core.Int64Ptr(*retentionDays)},
+	}
+	patch, err := rangePatch.AsPatch()
+	if err != nil {
+		return err
+	}
+
+	service, err := c.service()
+	if err != nil {
+		return err
+	}
+	recoveryRange, _, err := service.PatchSourceResourceRecoveryRangeWithContext(c.ctx, service.NewPatchSourceResourceRecoveryRangeOptions(args[0], args[1], patch))
+	if err != nil {
+		return err
+	}
+	return c.print(recoveryRange)
+}
+
+func rangeApplyRules(c *cli, args []string) error {
+	fs := c.flagSet("range apply-rules")
+	rulesFile := fs.String("rules", "", "JSON or YAML file listing the retention rules")
+	dryRun := fs.Bool("dry-run", false, "only show the changes the rules require")
+	args, err := c.parse(fs, args, 1)
+	if err != nil {
+		return err
+	}
+	if *rulesFile == "" {
+		return fmt.Errorf("--rules is required")
+	}
+	data, err := os.ReadFile(*rulesFile)
+	if err != nil {
+		return err
+	}
+	var rules []rc.RetentionRule
+	if err = yaml.Unmarshal(data, &rules); err != nil {
+		return fmt.Errorf("invalid rules %s: %s", *rulesFile, err.Error())
+	}
+
+	service, err := c.service()
+	if err != nil {
+		return err
+	}
+	options := service.NewApplyRetentionRulesOptions(args[0], rules)
+	options.SetDryRun(*dryRun)
+	audit, err := service.ApplyRetentionRulesWithContext(c.ctx, options)
+	if audit == nil {
+		return err
+	}
+	if printErr := c.print(audit); printErr != nil && err == nil {
+		err = printErr
+	}
+	if failures := audit.Failures(); len(failures) > 0 && err == nil {
+		err = fmt.Errorf("%d of %d retention changes failed", len(failures), len(audit.Changes))
+	}
+	return err
+}
@@ -0,0 +1,107 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	rc "github.com/IBM/ibm-cos-sdk-go-config/v2/resourceconfigurationv1"
+	"sigs.k8s.io/yaml"
+)
+
+func reportCoverage(c *cli, args []string) error {
+	fs := c.flagSet("report coverage")
+	staleAfter := fs.Duration("stale-after", rc.DefaultCoverageStaleAfter, "recovery point gap after which a recovery range is flagged stale")
+	args, err := c.parse(fs, args, oneOrMore)
+	if err != nil {
+		return err
+	}
+
+	service, err := c.service()
+	if err != nil {
+		return err
+	}
+	options := service.NewCoverageReportOptions(args)
+	options.SetStaleAfter(*staleAfter)
+	report, err := service.GetCoverageReportWithContext(c.ctx, options)
+	if err != nil {
+		return err
+	}
+	return c.print(report)
+}
+
+func reportExpiry(c *cli, args []string) error {
+	fs := c.flagSet("report expiry")
+	instanceID := fs.String("instance-id", "", "service instance id whose vaults are searched (default $"+envServiceInstanceID+")")
+	days := fs.Int("days", int(rc.DefaultRetentionCalendarWithin/(24*time.Hour)), "list recovery ranges that expire within this many days")
+	if _, err := c.parse(fs, args, 0); err != nil {
+		return err
+	}
+	if *days < 1 {
+		return fmt.Errorf("--days must be at least 1")
+	}
+
+	service, err := c.service()
+	if err != nil {
+		return err
+	}
+	id, err := serviceInstanceID(service, *instanceID)
+	if err != nil {
+		return err
+	}
+	options := service.NewRetentionCalendarOptions(id)
+	options.SetWithin(time.Duration(*days) * 24 * time.Hour)
+	calendar, err := service.GetRetentionCalendarWithContext(c.ctx, options)
+	if err != nil {
+		return err
+	}
+	return c.print(calendar)
+}
+
+func reportUsage(c *cli, args []string) error {
+	fs := c.flagSet("report usage")
+	pricesFile := fs.String("prices", "", "JSON or YAML price table to calculate monthly costs with")
+	args, err := c.parse(fs, args, oneOrMore)
+	if err != nil {
+		return err
+	}
+	var priceTable *rc.PriceTable
+	if *pricesFile != "" {
+		data, err := os.ReadFile(*pricesFile)
+		if err != nil {
+			return err
+		}
+		priceTable = &rc.PriceTable{}
+		if err = yaml.Unmarshal(data, priceTable); err != nil {
+			return fmt.Errorf("invalid price table %s: %s", *pricesFile, err.Error())
+		}
+	}
+
+	service, err := c.service()
+	if err != nil {
+		return err
+	}
+	options := service.NewUsageReportOptions(args)
+	options.SetPriceTable(priceTable)
+	report, err := service.GetUsageReportWithContext(c.ctx, options)
+	if err != nil {
+		return err
+	}
+	return c.print(report)
+}
@@ -0,0 +1,224 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	rc "github.com/IBM/ibm-cos-sdk-go-config/v2/resourceconfigurationv1"
+	"sigs.k8s.io/yaml"
+)
+
+func restoreCreate(c *cli, args []string) error {
+	fs := c.flagSet("restore create")
+	rangeID := fs.String("range-id", "", "id of the recovery range to restore from")
+	pointInTime := fs.String("point-in-time", "", "point in time to restore to (RFC 3339)")
+	targetCrn := fs.String("target-crn", "", "CRN of the bucket to restore into")
+	restoreType := fs.String("type", rc.CreateRestoreOptions_RestoreType_InPlace, "type of restore")
+	args, err := c.parse(fs, args, 1)
+	if err != nil {
+		return err
+	}
+	if *rangeID == "" || *pointInTime == "" || *targetCrn == "" {
+		return fmt.Errorf("--range-id, --point-in-time and --target-crn are required")
+	}
+	restorePointInTime, err := core.ParseDateTime(*pointInTime)
+	if err != nil {
+		return fmt.Errorf("invalid --point-in-time: %s", err.Error())
+	}
+
+	service, err := c.service()
+	if err != nil {
+		return err
+	}
+	options := service.NewCreateRestoreOptions(args[0], *rangeID, *restoreType, &restorePointInTime, *targetCrn)
+	restore, _, err := service.CreateRestoreWithContext(c.ctx, options)
+	if err != nil {
+		return err
+	}
+	return c.print(restore)
+}
+
+func restoreResolve(c *cli, args []string) error {
+	fs := c.flagSet("restore resolve")
+	instanceID := fs.String("instance-id", "", "service instance id whose vaults are searched (default $"+envServiceInstanceID+")")
+	pointInTime := fs.String("point-in-time", "", "point in time to restore to (RFC 3339)")
+	args, err := c.parse(fs, args, 1)
+	if err != nil {
+		return err
+	}
+	if *pointInTime == "" {
+		return fmt.Errorf("--point-in-time is required")
+	}
+	restorePointInTime, err := core.ParseDateTime(*pointInTime)
+	if err != nil {
+		return fmt.Errorf("invalid --point-in-time: %s", err.Error())
+	}
+
+	service, err := c.service()
+	if err != nil {
+		return err
+	}
+	id, err := serviceInstanceID(service, *instanceID)
+	if err != nil {
+		return err
+	}
+	candidates, err := service.ResolvePointInTimeWithContext(c.ctx, service.NewResolvePointInTimeOptions(id, args[0], &restorePointInTime))
+	if err != nil {
+		return err
+	}
+	return c.print(candidates)
+}
+
+func restoreBulk(c *cli, args []string) error {
+	fs := c.flagSet("restore bulk")
+	instanceID := fs.String("instance-id", "", "service instance id whose vaults are searched (default $"+envServiceInstanceID+")")
+	concurrency := fs.Int("concurrency", rc.DefaultBulkRestoreConcurrency, "maximum number of restores in progress at once")
+	startInterval := fs.Duration("start-interval", 0, "minimum time between two restores being started")
+	interval := fs.Duration("interval", rc.DefaultWaitPollInterval, "time between status checks")
+	args, err := c.parse(fs, args, 1)
+	if err != nil {
+		return err
+	}
+	data, err := os.ReadFile(args[0])
+	if err != nil {
+		return err
+	}
+	var items []rc.BulkRestoreItem
+	if err = yaml.Unmarshal(data, &items); err != nil {
+		return fmt.Errorf("invalid restore list %s: %s", args[0], err.Error())
+	}
+
+	service, err := c.service()
+	if err != nil {
+		return err
+	}
+	id, err := serviceInstanceID(service, *instanceID)
+	if err != nil {
+		return err
+	}
+	options := service.NewBulkRestoreOptions(id, items)
+	options.SetConcurrency(*concurrency)
+	options.SetStartInterval(*startInterval)
+	options.SetPollInterval(*interval)
+	options.SetOnProgress(func(event *rc.BulkRestoreEvent) {
+		detail := ""
+		switch {
+		case event.Error != "":
+			detail = ": " + event.Error
+		case event.Restore != nil && event.Restore.RestorePercentProgress != nil:
+			detail = fmt.Sprintf(" (%d%%)", *event.Restore.RestorePercentProgress)
+		}
+		fmt.Fprintf(c.stderr, "[%d] %s: %s%s\n", event.Index+1, core.StringNilMapper(event.Item.SourceResourceCrn), event.Phase, detail)
+	})
+	report, err := service.RunBulkRestoreWithContext(c.ctx, options)
+	if report == nil {
+		return err
+	}
+	if printErr := c.print(report); printErr != nil && err == nil {
+		err = printErr
+	}
+	if failures := report.Failures(); len(failures) > 0 && err == nil {
+		err = fmt.Errorf("%d of %d restores did not complete", len(failures), len(report.Results))
+	}
+	return err
+}
+
+func restoreList(c *cli, args []string) error {
+	fs := c.flagSet("restore list")
+	args, err := c.parse(fs, args, 1)
+	if err != nil {
+		return err
+	}
+
+	service, err := c.service()
+	if err != nil {
+		return err
+	}
+	pager, err := service.NewRestoresPager(service.NewListRestoresOptions(args[0]))
+	if err != nil {
+		return err
+	}
+	restores, err := pager.GetAllWithContext(c.ctx)
+	if err != nil {
+		return err
+	}
+	if restores == nil {
+		restores = []rc.Restore{}
+	}
+	return c.print(restores)
+}
+
+func restoreGet(c *cli, args []string) error {
+	fs := c.flagSet("restore get")
+	args, err := c.parse(fs, args, 2)
+	if err != nil {
+		return err
+	}
+
+	service, err := c.service()
+	if err != nil {
+		return err
+	}
+	restore, _, err := service.GetRestoreWithContext(c.ctx, service.NewGetRestoreOptions(args[0], args[1]))
+	if err != nil {
+		return err
+	}
+	return c.print(restore)
+}
+
+func restoreWait(c *cli, args []string) error {
+	fs := c.flagSet("restore wait")
+	interval := fs.Duration("interval", rc.DefaultWaitPollInterval, "time between status checks")
+	timeout := fs.Duration("timeout", 0, "give up after this long (0 waits forever)")
+	args, err := c.parse(fs, args, 2)
+	if err != nil {
+		return err
+	}
+
+	service, err := c.service()
+	if err != nil {
+		return err
+	}
+
+	ctx := c.ctx
+	if *timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *timeout)
+		defer cancel()
+	}
+
+	options := service.NewWaitForRestoreOptions(args[0], args[1])
+	options.SetPollInterval(*interval)
+	options.SetOnPoll(func(restore *rc.Restore) {
+		progress := ""
+		if restore.RestorePercentProgress != nil {
+			progress = fmt.Sprintf(" (%d%%)", *restore.RestorePercentProgress)
+		}
+		fmt.Fprintf(c.stderr, "restore %s: %s%s\n", args[1], core.StringNilMapper(restore.RestoreStatus), progress)
+	})
+	restore, err := service.WaitForRestoreWithContext(ctx, options)
+	if restore != nil {
+		if printErr := c.print(restore); printErr != nil && err == nil {
+			err = printErr
+		}
+	}
+	return err
+}
@@ -0,0 +1,205 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+
+	rc "github.com/IBM/ibm-cos-sdk-go-config/v2/resourceconfigurationv1"
+)
+
+// tablePrinter renders models as aligned columns with a header row.
+type tablePrinter struct{}
+
+func (tablePrinter) print(w io.Writer, result interface{}) error {
+	// Reports lay out their own tables.
+	if report, ok := result.(interface{ WriteTable(io.Writer) error }); ok {
+		return report.WriteTable(w)
+	}
+
+	headers, rows, err := tableRows(result)
+	if err != nil {
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 4, 3, ' ', 0)
+	fmt.Fprintln(tw, strings.Join(headers, "\t"))
+	for _, row := range rows {
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+	return tw.Flush()
+}
+
+// Default columns of each model.
+var (
+	bucketColumns        = []string{"NAME", "OBJECTS", "BYTES USED", "NONCURRENT BYTES", "DELETE MARKERS", "HARD QUOTA", "CREATED"}
+	vaultNameColumns     = []string{"NAME"}
+	vaultColumns         = []string{"NAME", "REGION", "BYTES USED", "KMS ROOT KEY", "CREATED", "UPDATED"}
+	policyColumns        = []string{"ID", "NAME", "STATUS", "INITIAL SYNC", "RETENTION DAYS", "TARGET VAULT"}
+	recoveryRangeColumns = []string{"ID", "SOURCE", "POLICY", "START", "END", "RETENTION DAYS"}
+	candidateColumns     = append([]string{"VAULT"}, recoveryRangeColumns...)
+	restoreColumns       = []string{"ID", "STATUS", "PROGRESS", "POINT IN TIME", "STARTED", "COMPLETED", "TARGET"}
+)
+
+// tableRows returns the header and rows used to show "result" as a table.
+func tableRows(result interface{}) (headers []string, rows [][]string, err error) {
+	switch v := result.(type) {
+	case *rc.Bucket:
+		return bucketColumns, [][]string{bucketRow(v)}, nil
+	case []string:
+		for _, name := range v {
+			rows = append(rows, []string{name})
+		}
+		return vaultNameColumns, rows, nil
+	case *rc.BackupVault:
+		return vaultColumns, [][]string{vaultRow(v)}, nil
+	case *rc.BackupPolicy:
+		return policyColumns, [][]string{policyRow(v)}, nil
+	case *rc.BackupPolicyCollection:
+		for i := range v.BackupPolicies {
+			rows = append(rows, policyRow(&v.BackupPolicies[i]))
+		}
+		return policyColumns, rows, nil
+	case *rc.RecoveryRange:
+		return recoveryRangeColumns, [][]string{recoveryRangeRow(v)}, nil
+	case []rc.RecoveryRange:
+		for i := range v {
+			rows = append(rows, recoveryRangeRow(&v[i]))
+		}
+		return recoveryRangeColumns, rows, nil
+	case []rc.PointInTimeCandidate:
+		for i := range v {
+			rows = append(rows, append([]string{v[i].BackupVaultName}, recoveryRangeRow(v[i].RecoveryRange)...))
+		}
+		return candidateColumns, rows, nil
+	case *rc.Restore:
+		return restoreColumns, [][]string{restoreRow(v)}, nil
+	case []rc.Restore:
+		for i := range v {
+			rows = append(rows, restoreRow(&v[i]))
+		}
+		return restoreColumns, rows, nil
+	}
+	return nil, nil, fmt.Errorf("table output is not supported for %T", result)
+}
+
+func bucketRow(bucket *rc.Bucket) []string {
+	return []string{
+		cell(bucket.Name),
+		intCell(bucket.ObjectCount),
+		intCell(bucket.BytesUsed),
+		intCell(bucket.NoncurrentBytesUsed),
+		intCell(bucket.DeleteMarkerCount),
+		intCell(bucket.HardQuota),
+		timeCell(bucket.TimeCreated),
+	}
+}
+
+func vaultRow(vault *rc.BackupVault) []string {
+	return []string{
+		cell(vault.BackupVaultName),
+		cell(vault.Region),
+		intCell(vault.BytesUsed),
+		cell(vault.SseKpCustomerRootKeyCrn),
+		timeCell(vault.TimeCreated),
+		timeCell(vault.TimeUpdated),
+	}
+}
+
+func policyRow(policy *rc.BackupPolicy) []string {
+	var retention *int64
+	if policy.InitialRetention != nil {
+		retention = policy.InitialRetention.DeleteAfterDays
+	}
+	progress := "-"
+	if policy.InitialSyncProgress != nil {
+		progress = strconv.FormatFloat(*policy.InitialSyncProgress, 'f', -1, 64) + "%"
+	}
+	return []string{
+		cell(policy.PolicyID),
+		cell(policy.PolicyName),
+		cell(policy.PolicyStatus),
+		progress,
+		intCell(retention),
+		cell(policy.TargetBackupVaultCrn),
+	}
+}
+
+func recoveryRangeRow(recoveryRange *rc.RecoveryRange) []string {
+	retention := "-"
+	if recoveryRange.Retention != nil && recoveryRange.Retention.DeleteAfterDays != nil {
+		if *recoveryRange.Retention.DeleteAfterDays == -1 {
+			retention = "indefinite"
+		} else {
+			retention = strconv.FormatInt(*recoveryRange.Retention.DeleteAfterDays, 10)
+		}
+	}
+	return []string{
+		cell(recoveryRange.RecoveryRangeID),
+		cell(recoveryRange.SourceResourceCrn),
+		cell(recoveryRange.BackupPolicyName),
+		timeCell(recoveryRange.RangeStartTime),
+		timeCell(recoveryRange.RangeEndTime),
+		retention,
+	}
+}
+
+func restoreRow(restore *rc.Restore) []string {
+	progress := "-"
+	if restore.RestorePercentProgress != nil {
+		progress = strconv.FormatInt(*restore.RestorePercentProgress, 10) + "%"
+	} else if restore.RestoreStatus != nil && *restore.RestoreStatus == rc.Restore_RestoreStatus_Complete {
+		progress = "100%"
+	}
+	return []string{
+		cell(restore.RestoreID),
+		cell(restore.RestoreStatus),
+		progress,
+		timeCell(restore.RestorePointInTime),
+		timeCell(restore.InitTime),
+		timeCell(restore.CompleteTime),
+		cell(restore.TargetResourceCrn),
+	}
+}
+
+func cell(value *string) string {
+	if value == nil || *value == "" {
+		return "-"
+	}
+	return *value
+}
+
+func intCell(value *int64) string {
+	if value == nil {
+		return "-"
+	}
+	return strconv.FormatInt(*value, 10)
+}
+
+func timeCell(value *strfmt.DateTime) string {
+	if value == nil {
+		return "-"
+	}
+	return time.Time(*value).UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,184 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	rc "github.com/IBM/ibm-cos-sdk-go-config/v2/resourceconfigurationv1"
+)
+
+func vaultList(c *cli, args []string) error {
+	fs := c.flagSet("vault list")
+	instanceID := fs.String("instance-id", "", "service instance id (default $"+envServiceInstanceID+")")
+	if _, err := c.parse(fs, args, 0); err != nil {
+		return err
+	}
+	service, err := c.service()
+	if err != nil {
+		return err
+	}
+	id, err := serviceInstanceID(service, *instanceID)
+	if err != nil {
+		return err
+	}
+	pager, err := service.NewBackupVaultsPager(service.NewListBackupVaultsOptions(id))
+	if err != nil {
+		return err
+	}
+	vaults, err := pager.GetAllWithContext(c.ctx)
+	if err != nil {
+		return err
+	}
+	if vaults == nil {
+		vaults = []string{}
+	}
+	return c.print(vaults)
+}
+
+func vaultCreate(c *cli, args []string) error {
+	fs := c.flagSet("vault create")
+	instanceID := fs.String("instance-id", "", "service instance id (default $"+envServiceInstanceID+")")
+	region := fs.String("region", "", "region to create the vault in")
+	kmsKeyCrn := fs.String("kms-key-crn", "", "CRN of the Key Protect root key used to encrypt the vault")
+	var managementEvents, usageMetrics optionalBool
+	fs.Var(&managementEvents, "management-events", "send management events to Activity Tracker")
+	fs.Var(&usageMetrics, "usage-metrics", "send usage metrics to IBM Cloud Monitoring")
+	args, err := c.parse(fs, args, 1)
+	if err != nil {
+		return err
+	}
+	if *region == "" {
+		return fmt.Errorf("--region is required")
+	}
+
+	service, err := c.service()
+	if err != nil {
+		return err
+	}
+	id, err := serviceInstanceID(service, *instanceID)
+	if err != nil {
+		return err
+	}
+	options := service.NewCreateBackupVaultOptions(id, args[0], *region)
+	if *kmsKeyCrn != "" {
+		options.SetSseKpCustomerRootKeyCrn(*kmsKeyCrn)
+	}
+	if managementEvents.value != nil {
+		options.SetActivityTracking(&rc.BackupVaultActivityTracking{ManagementEvents: managementEvents.value})
+	}
+	if usageMetrics.value != nil {
+		options.SetMetricsMonitoring(&rc.BackupVaultMetricsMonitoring{UsageMetricsEnabled: usageMetrics.value})
+	}
+	vault, _, err := service.CreateBackupVaultWithContext(c.ctx, options)
+	if err != nil {
+		return err
+	}
+	return c.print(vault)
+}
+
+func vaultGet(c *cli, args []string) error {
+	fs := c.flagSet("vault get")
+	args, err := c.parse(fs, args, 1)
+	if err != nil {
+		return err
+	}
+
+	service, err := c.service()
+	if err != nil {
+		return err
+	}
+	vault, _, err := service.GetBackupVaultWithContext(c.ctx, service.NewGetBackupVaultOptions(args[0]))
+	if err != nil {
+		return err
+	}
+	return c.print(vault)
+}
+
+func vaultUpdate(c *cli, args []string) error {
+	fs := c.flagSet("vault update")
+	var managementEvents, usageMetrics optionalBool
+	fs.Var(&managementEvents, "management-events", "send management events to Activity Tracker")
+	fs.Var(&usageMetrics, "usage-metrics", "send usage metrics to IBM Cloud Monitoring")
+	ifMatch := fs.String("if-match", "", "only update if the vault ETag matches")
+	args, err := c.parse(fs, args, 1)
+	if err != nil {
+		return err
+	}
+
+	vaultPatch := &rc.BackupVaultPatch{}
+	if managementEvents.value != nil {
+		vaultPatch.ActivityTracking = &rc.BackupVaultActivityTracking{ManagementEvents: managementEvents.value}
+	}
+	if usageMetrics.value != nil {
+		vaultPatch.MetricsMonitoring = &rc.BackupVaultMetricsMonitoring{UsageMetricsEnabled: usageMetrics.value}
+	}
+	patch, err := vaultPatch.AsPatch()
+	if err != nil {
+		return err
+	}
+	if len(patch) == 0 {
+		return fmt.Errorf("nothing to update: set --management-events and/or --usage-metrics")
+	}
+
+	service, err := c.service()
+	if err != nil {
+		return err
+	}
+	options := service.NewUpdateBackupVaultOptions(args[0], patch)
+	if *ifMatch != "" {
+		options.SetIfMatch(*ifMatch)
+	}
+	vault, _, err := service.UpdateBackupVaultWithContext(c.ctx, options)
+	if err != nil {
+		return err
+	}
+	return c.print(vault)
+}
+
+func vaultDelete(c *cli, args []string) error {
+	fs := c.flagSet("vault delete")
+	buckets := fs.String("buckets", "", "comma-separated buckets whose backup policies may target the vault")
+	cascade := fs.Bool("cascade", false, "also delete the backup policies that target the vault")
+	confirm := fs.String("confirm", "", "the vault name, to confirm --cascade")
+	args, err := c.parse(fs, args, 1)
+	if err != nil {
+		return err
+	}
+
+	service, err := c.service()
+	if err != nil {
+		return err
+	}
+	options := service.NewSafeDeleteBackupVaultOptions(args[0])
+	if *buckets != "" {
+		options.SetBuckets(strings.Split(*buckets, ","))
+	}
+	if *cascade {
+		options.SetCascade(*confirm)
+	}
+	dependencies, _, err := service.SafeDeleteBackupVaultWithContext(c.ctx, options)
+	if err != nil {
+		return err
+	}
+	for _, dependent := range dependencies.BackupPolicies {
+		c.printStatus("Deleted backup policy %s of bucket %s", *dependent.Policy.PolicyID, dependent.Bucket)
+	}
+	c.printStatus("Deleted backup vault %s", args[0])
+	return nil
+}
@@ -0,0 +1,317 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package crn parses, validates and builds IBM Cloud Resource Names (CRNs), such as the bucket, backup vault,
+// Key Protect and Hyper Protect Crypto Services key and Activity Tracker CRNs used by the Resource Configuration API.
+//
+// A CRN has ten colon-separated segments:
+//
+//	crn:v1:bluemix:public:cloud-object-storage:global:a/<account>:<service instance>:bucket:<bucket name>
+//	crn:<version>:<cname>:<ctype>:<service name>:<location>:<scope>:<service instance>:<resource type>:<resource>
+package crn
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/ibm-cos-sdk-go-config/v2/common"
+)
+
+// Segment values used by the typed helpers.
+const (
+	// Version is the only CRN version.
+	Version = "v1"
+
+	// CNameBluemix is the cloud name of IBM Cloud.
+	CNameBluemix = "bluemix"
+
+	// CTypePublic is the cloud type of the public IBM Cloud.
+	CTypePublic = "public"
+
+	// LocationGlobal is the location of global resources such as buckets and backup vaults.
+	LocationGlobal = "global"
+
+	// ScopeAccountPrefix prefixes the account ID in the scope segment.
+	ScopeAccountPrefix = "a/"
+)
+
+// Service names.
+const (
+	ServiceCloudObjectStorage = "cloud-object-storage"
+	ServiceKeyProtect         = "kms"
+	ServiceHyperProtect       = "hs-crypto"
+	ServiceActivityTracker    = "logdnaat"
+)
+
+// Resource types.
+const (
+	ResourceTypeBucket      = "bucket"
+	ResourceTypeBackupVault = "backup-vault"
+	ResourceTypeKey         = "key"
+)
+
+// segments is the number of colon-separated segments of a CRN. Only the last one, the resource, may contain colons.
+const segments = 10
+
+var (
+	// uuidPattern matches regular service instance IDs.
+	uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+	// softLayerPattern matches SoftLayer service instance IDs, which are not separated by dashes.
+	softLayerPattern = regexp.MustCompile(`^[0-9a-fA-F]{32}$`)
+)
+
+// CRN : A parsed Cloud Resource Name.
+type CRN struct {
+	Version         string
+	CName           string
+	CType           string
+	ServiceName     string
+	Location        string
+	Scope           string
+	ServiceInstance string
+	ResourceType    string
+	Resource        string
+}
+
+// Parse splits "s" into its segments and validates them. It does not check the service or resource type; use the
+// typed Parse functions for that.
+func Parse(s string) (*CRN, error) {
+	parts := strings.SplitN(s, ":", segments)
+	if len(parts) != segments || parts[0] != "crn" {
+		return nil, newError(fmt.Sprintf("%q is not a CRN: expected %d colon-separated segments starting with \"crn\"", s, segments), "crn-parse-error")
+	}
+	crn := &CRN{
+		Version:         parts[1],
+		CName:           parts[2],
+		CType:           parts[3],
+		ServiceName:     parts[4],
+		Location:        parts[5],
+		Scope:           parts[6],
+		ServiceInstance: parts[7],
+		ResourceType:    parts[8],
+		Resource:        parts[9],
+	}
+	if err := crn.Validate(); err != nil {
+		return nil, err
+	}
+	return crn, nil
+}
+
+// Validate checks that the CRN has a supported version and the segments every CRN requires.
+func (crn *CRN) Validate() error {
+	if crn.Version != Version {
+		return newError(fmt.Sprintf("unsupported CRN version %q", crn.Version), "crn-version-error")
+	}
+	for _, segment := range []struct {
+		name  string
+		value string
+	}{
+		{"cname", crn.CName},
+		{"ctype", crn.CType},
+		{"service name", crn.ServiceName},
+		{"location", crn.Location},
+	} {
+		if segment.value == "" {
+			return newError(fmt.Sprintf("the CRN %s segment is empty", segment.name), "crn-segment-error")
+		}
+	}
+	for _, value := range []string{crn.Version, crn.CName, crn.CType, crn.ServiceName, crn.Location, crn.Scope,
+		crn.ServiceInstance, crn.ResourceType} {
+		if strings.Contains(value, ":") {
+			return newError(fmt.Sprintf("the CRN segment %q contains a colon", value), "crn-segment-error")
+		}
+	}
+	if crn.ResourceType != "" && crn.Resource == "" {
+		return newError(fmt.Sprintf("the CRN has resource type %q but no resource", crn.ResourceType), "crn-segment-error")
+	}
+	return nil
+}
+
+// String returns the CRN in its textual form.
+func (crn *CRN) String() string {
+	return strings.Join([]string{"crn", crn.Version, crn.CName, crn.CType, crn.ServiceName, crn.Location, crn.Scope,
+		crn.ServiceInstance, crn.ResourceType, crn.Resource}, ":")
+}
+
+// AccountID returns the account ID of an account scope ("a/<account>"), or "" for other scopes.
+func (crn *CRN) AccountID() string {
+	if strings.HasPrefix(crn.Scope, ScopeAccountPrefix) {
+		return strings.TrimPrefix(crn.Scope, ScopeAccountPrefix)
+	}
+	return ""
+}
+
+// IsSoftLayer reports whether the service instance ID is in the SoftLayer form, 32 hexadecimal digits without
+// dashes, rather than a UUID. Bucket backup is not supported for SoftLayer accounts, but their CRNs are valid.
+func (crn *CRN) IsSoftLayer() bool {
+	return softLayerPattern.MatchString(crn.ServiceInstance)
+}
+
+// HasServiceInstanceID reports whether the service instance ID is a UUID or a SoftLayer ID.
+func (crn *CRN) HasServiceInstanceID() bool {
+	return uuidPattern.MatchString(crn.ServiceInstance) || crn.IsSoftLayer()
+}
+
+// ServiceInstanceCRN returns the CRN of the service instance that owns the resource.
+func (crn *CRN) ServiceInstanceCRN() *CRN {
+	instance := *crn
+	instance.ResourceType = ""
+	instance.Resource = ""
+	return &instance
+}
+
+// IsBucket reports whether the CRN identifies a COS bucket.
+func (crn *CRN) IsBucket() bool {
+	return crn.ServiceName == ServiceCloudObjectStorage && crn.ResourceType == ResourceTypeBucket
+}
+
+// IsBackupVault reports whether the CRN identifies a COS backup vault.
+func (crn *CRN) IsBackupVault() bool {
+	return crn.ServiceName == ServiceCloudObjectStorage && crn.ResourceType == ResourceTypeBackupVault
+}
+
+// IsServiceInstance reports whether the CRN identifies a COS service instance.
+func (crn *CRN) IsServiceInstance() bool {
+	return crn.ServiceName == ServiceCloudObjectStorage && crn.ResourceType == "" && crn.Resource == "" &&
+		crn.ServiceInstance != ""
+}
+
+// IsKeyProtectKey reports whether the CRN identifies a Key Protect key.
+func (crn *CRN) IsKeyProtectKey() bool {
+	return crn.ServiceName == ServiceKeyProtect && crn.ResourceType == ResourceTypeKey
+}
+
+// IsHyperProtectKey reports whether the CRN identifies a Hyper Protect Crypto Services key.
+func (crn *CRN) IsHyperProtectKey() bool {
+	return crn.ServiceName == ServiceHyperProtect && crn.ResourceType == ResourceTypeKey
+}
+
+// IsRootKey reports whether the CRN identifies a key that can encrypt a backup vault: a Key Protect or a Hyper Protect
+// Crypto Services key.
+func (crn *CRN) IsRootKey() bool {
+	return crn.IsKeyProtectKey() || crn.IsHyperProtectKey()
+}
+
+// IsActivityTracker reports whether the CRN identifies an Activity Tracker instance.
+func (crn *CRN) IsActivityTracker() bool {
+	return crn.ServiceName == ServiceActivityTracker && crn.ServiceInstance != ""
+}
+
+// ParseBucket parses the CRN of a COS bucket.
+func ParseBucket(s string) (*CRN, error) {
+	return parseTyped(s, "bucket", (*CRN).IsBucket)
+}
+
+// ParseBackupVault parses the CRN of a COS backup vault.
+func ParseBackupVault(s string) (*CRN, error) {
+	return parseTyped(s, "backup vault", (*CRN).IsBackupVault)
+}
+
+// ParseServiceInstance parses the CRN of a COS service instance.
+func ParseServiceInstance(s string) (*CRN, error) {
+	return parseTyped(s, "COS service instance", (*CRN).IsServiceInstance)
+}
+
+// ParseKeyProtectKey parses the CRN of a Key Protect key.
+func ParseKeyProtectKey(s string) (*CRN, error) {
+	return parseTyped(s, "Key Protect key", (*CRN).IsKeyProtectKey)
+}
+
+// ParseRootKey parses the CRN of a root key used for backup vault encryption, which is either a Key Protect or a
+// Hyper Protect Crypto Services key.
+func ParseRootKey(s string) (*CRN, error) {
+	return parseTyped(s, "Key Protect or Hyper Protect Crypto Services key", (*CRN).IsRootKey)
+}
+
+// ParseActivityTracker parses the CRN of an Activity Tracker instance.
+func ParseActivityTracker(s string) (*CRN, error) {
+	return parseTyped(s, "Activity Tracker instance", (*CRN).IsActivityTracker)
+}
+
+func parseTyped(s string, kind string, is func(*CRN) bool) (*CRN, error) {
+	crn, err := Parse(s)
+	if err != nil {
+		return nil, err
+	}
+	if !is(crn) {
+		return nil, newError(fmt.Sprintf("%q is not the CRN of a %s", s, kind), "crn-type-error")
+	}
+	return crn, nil
+}
+
+// NewServiceInstance returns the CRN of a COS service instance in the public IBM Cloud.
+func NewServiceInstance(accountID string, serviceInstanceID string) *CRN {
+	return &CRN{
+		Version:         Version,
+		CName:           CNameBluemix,
+		CType:           CTypePublic,
+		ServiceName:     ServiceCloudObjectStorage,
+		Location:        LocationGlobal,
+		Scope:           ScopeAccountPrefix + accountID,
+		ServiceInstance: serviceInstanceID,
+	}
+}
+
+// NewBucket returns the CRN of a bucket of a COS service instance in the public IBM Cloud.
+func NewBucket(accountID string, serviceInstanceID string, bucketName string) *CRN {
+	crn := NewServiceInstance(accountID, serviceInstanceID)
+	crn.ResourceType = ResourceTypeBucket
+	crn.Resource = bucketName
+	return crn
+}
+
+// NewBackupVault returns the CRN of a backup vault of a COS service instance in the public IBM Cloud.
+func NewBackupVault(accountID string, serviceInstanceID string, backupVaultName string) *CRN {
+	crn := NewServiceInstance(accountID, serviceInstanceID)
+	crn.ResourceType = ResourceTypeBackupVault
+	crn.Resource = backupVaultName
+	return crn
+}
+
+// NewKeyProtectKey returns the CRN of a key of a Key Protect instance in "region" of the public IBM Cloud.
+func NewKeyProtectKey(region string, accountID string, instanceID string, keyID string) *CRN {
+	return &CRN{
+		Version:         Version,
+		CName:           CNameBluemix,
+		CType:           CTypePublic,
+		ServiceName:     ServiceKeyProtect,
+		Location:        region,
+		Scope:           ScopeAccountPrefix + accountID,
+		ServiceInstance: instanceID,
+		ResourceType:    ResourceTypeKey,
+		Resource:        keyID,
+	}
+}
+
+// NewActivityTracker returns the CRN of an Activity Tracker instance in "region" of the public IBM Cloud.
+func NewActivityTracker(region string, accountID string, instanceID string) *CRN {
+	return &CRN{
+		Version:         Version,
+		CName:           CNameBluemix,
+		CType:           CTypePublic,
+		ServiceName:     ServiceActivityTracker,
+		Location:        region,
+		Scope:           ScopeAccountPrefix + accountID,
+		ServiceInstance: instanceID,
+	}
+}
+
+func newError(message string, discriminator string) error {
+	return core.SDKErrorf(nil, message, discriminator, common.GetComponentInfo())
+}
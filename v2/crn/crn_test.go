@@ -0,0 +1,157 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crn
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	bucketCRN      = "crn:v1:bluemix:public:cloud-object-storage:global:a/3bf0d9003abfb5d29761c3e97696b71c:d6f04d83-6c4f-4a62-a165-696756d63903:bucket:my-new-bucket"
+	softLayerCRN   = "crn:v1:bluemix:public:cloud-object-storage:global:a/1229395:8dfbcba4e6a740e3866020847e525436:bucket:targetbucket"
+	vaultCRN       = "crn:v1:bluemix:public:cloud-object-storage:global:a/3bf0d9003abfb5d29761c3e97696b71c:d6f04d83-6c4f-4a62-a165-696756d63903:backup-vault:my-vault"
+	instanceCRN    = "crn:v1:bluemix:public:cloud-object-storage:global:a/3bf0d9003abfb5d29761c3e97696b71c:d6f04d83-6c4f-4a62-a165-696756d63903::"
+	keyCRN         = "crn:v1:bluemix:public:kms:us-south:a/3bf0d9003abfb5d29761c3e97696b71c:12e8c9c2-a162-472d-b7d6-8b9a86b815a6:key:02fd6835-6001-4482-a892-13bd2085f75d"
+	hpcsKeyCRN     = "crn:v1:bluemix:public:hs-crypto:us-south:a/3bf0d9003abfb5d29761c3e97696b71c:12e8c9c2-a162-472d-b7d6-8b9a86b815a6:key:02fd6835-6001-4482-a892-13bd2085f75d"
+	atCRN          = "crn:v1:bluemix:public:logdnaat:us-south:a/3bf0d9003abfb5d29761c3e97696b71c:8d7c6a57-6c4f-4a62-a165-696756d63903::"
+	accountID      = "3bf0d9003abfb5d29761c3e97696b71c"
+	instanceID     = "d6f04d83-6c4f-4a62-a165-696756d63903"
+	keyInstanceID  = "12e8c9c2-a162-472d-b7d6-8b9a86b815a6"
+	keyID          = "02fd6835-6001-4482-a892-13bd2085f75d"
+	atInstanceID   = "8d7c6a57-6c4f-4a62-a165-696756d63903"
+	bucketName     = "my-new-bucket"
+	backupVaultRef = "my-vault"
+)
+
+func TestParse(t *testing.T) {
+	crn, err := Parse(bucketCRN)
+	assert.Nil(t, err)
+	assert.Equal(t, &CRN{
+		Version:         "v1",
+		CName:           "bluemix",
+		CType:           "public",
+		ServiceName:     "cloud-object-storage",
+		Location:        "global",
+		Scope:           "a/" + accountID,
+		ServiceInstance: instanceID,
+		ResourceType:    "bucket",
+		Resource:        bucketName,
+	}, crn)
+	assert.Equal(t, bucketCRN, crn.String())
+	assert.Equal(t, accountID, crn.AccountID())
+	assert.True(t, crn.HasServiceInstanceID())
+	assert.False(t, crn.IsSoftLayer())
+	assert.Equal(t, instanceCRN, crn.ServiceInstanceCRN().String())
+
+	crn, err = Parse(softLayerCRN)
+	assert.Nil(t, err)
+	assert.True(t, crn.IsSoftLayer())
+	assert.True(t, crn.HasServiceInstanceID())
+	assert.Equal(t, "1229395", crn.AccountID())
+}
+
+func TestParseErrors(t *testing.T) {
+	for input, message := range map[string]string{
+		"":                         "is not a CRN",
+		"crn:v1:bluemix:public":    "is not a CRN",
+		"urn:v1:a:b:c:d:e:f:g:h":   "is not a CRN",
+		"crn:v2:a:b:c:d:e:f:g:h":   `unsupported CRN version "v2"`,
+		"crn:v1::public:c:d:e:f::": "the CRN cname segment is empty",
+		"crn:v1:a:b::d:e:f::":      "the CRN service name segment is empty",
+		"crn:v1:a:b:c::e:f::":      "the CRN location segment is empty",
+		"crn:v1:a:b:c:d:e:f:g:":    `the CRN has resource type "g" but no resource`,
+	} {
+		crn, err := Parse(input)
+		assert.Nil(t, crn, input)
+		if assert.NotNil(t, err, input) {
+			assert.Contains(t, err.Error(), message, input)
+		}
+	}
+
+	crn := NewBucket(accountID, "a:b", bucketName)
+	assert.NotNil(t, crn.Validate())
+}
+
+func TestTypedParse(t *testing.T) {
+	parsers := map[string]func(string) (*CRN, error){
+		"bucket":           ParseBucket,
+		"backup vault":     ParseBackupVault,
+		"service instance": ParseServiceInstance,
+		"key":              ParseKeyProtectKey,
+		"activity tracker": ParseActivityTracker,
+	}
+	inputs := map[string]string{
+		"bucket":           bucketCRN,
+		"backup vault":     vaultCRN,
+		"service instance": instanceCRN,
+		"key":              keyCRN,
+		"activity tracker": atCRN,
+	}
+	for kind, parse := range parsers {
+		for inputKind, input := range inputs {
+			crn, err := parse(input)
+			if kind == inputKind {
+				assert.Nil(t, err, kind)
+				assert.Equal(t, input, crn.String(), kind)
+			} else {
+				assert.Nil(t, crn, kind+" "+inputKind)
+				if assert.NotNil(t, err, kind+" "+inputKind) {
+					assert.Contains(t, err.Error(), "is not the CRN of a", kind)
+				}
+			}
+		}
+	}
+
+	_, err := ParseBucket("crn:v1")
+	assert.Contains(t, err.Error(), "is not a CRN")
+}
+
+func TestParseRootKey(t *testing.T) {
+	for _, input := range []string{keyCRN, hpcsKeyCRN} {
+		crn, err := ParseRootKey(input)
+		assert.Nil(t, err, input)
+		assert.Equal(t, input, crn.String(), input)
+		assert.True(t, crn.IsRootKey(), input)
+	}
+
+	crn, err := ParseKeyProtectKey(hpcsKeyCRN)
+	assert.Nil(t, crn)
+	assert.NotNil(t, err)
+
+	for _, input := range []string{bucketCRN, vaultCRN, atCRN,
+		"crn:v1:bluemix:public:hs-crypto:us-south:a/3bf0d9003abfb5d29761c3e97696b71c:12e8c9c2-a162-472d-b7d6-8b9a86b815a6::"} {
+		crn, err := ParseRootKey(input)
+		assert.Nil(t, crn, input)
+		if assert.NotNil(t, err, input) {
+			assert.Contains(t, err.Error(), "is not the CRN of a Key Protect or Hyper Protect Crypto Services key", input)
+		}
+	}
+}
+
+func TestNew(t *testing.T) {
+	assert.Equal(t, bucketCRN, NewBucket(accountID, instanceID, bucketName).String())
+	assert.Equal(t, vaultCRN, NewBackupVault(accountID, instanceID, backupVaultRef).String())
+	assert.Equal(t, instanceCRN, NewServiceInstance(accountID, instanceID).String())
+	assert.Equal(t, keyCRN, NewKeyProtectKey("us-south", accountID, keyInstanceID, keyID).String())
+	assert.Equal(t, atCRN, NewActivityTracker("us-south", accountID, atInstanceID).String())
+
+	assert.True(t, NewBucket(accountID, instanceID, bucketName).IsBucket())
+	assert.True(t, NewBackupVault(accountID, instanceID, backupVaultRef).IsBackupVault())
+	assert.Nil(t, NewKeyProtectKey("us-south", accountID, keyInstanceID, keyID).Validate())
+}
@@ -48,15 +48,8 @@ func main() {
 	fmt.Println(d) // should print an empty bracket
 	fmt.Println(e) // should print <nil>
 
-	// Instantiate the IAM Authenticator with API KEY
-	authenticator := &core.IamAuthenticator{
-		ApiKey: apiKey,
-	}
-
-	// Build an RC Service with the IAM authenticator
-	service, serviceErr := rc.NewResourceConfigurationV1(&rc.ResourceConfigurationV1Options{
-		Authenticator: authenticator,
-	})
+	// Build an RC Service sharing the IAM credentials of the S3 client
+	service, serviceErr := rc.NewResourceConfigurationV1FromConfig(conf, nil)
 	// Check successful instantiation
 	if serviceErr != nil {
 		fmt.Println(serviceErr)
@@ -0,0 +1,116 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package otelmetrics reports the request metrics of a Resource Configuration client as OpenTelemetry metrics.
+//
+//	metrics, err := otelmetrics.NewRequestMetrics(nil)
+//	if err == nil {
+//		service.SetRequestMetrics(metrics)
+//	}
+package otelmetrics
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/ibm-cos-sdk-go-config/v2/common"
+	rc "github.com/IBM/ibm-cos-sdk-go-config/v2/resourceconfigurationv1"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// ScopeName is the instrumentation scope of the meter that creates the instruments.
+const ScopeName = "github.com/IBM/ibm-cos-sdk-go-config/v2/otelmetrics"
+
+// Attribute keys recorded with every measurement. "http.response.status_code" is omitted and "error.type" is set to
+// "error" when no response was received.
+const (
+	AttributeOperation  = attribute.Key("cos_config.operation")
+	AttributeMethod     = attribute.Key("http.request.method")
+	AttributeStatusCode = attribute.Key("http.response.status_code")
+	AttributeErrorType  = attribute.Key("error.type")
+)
+
+// RequestMetrics : A resourceconfigurationv1.RequestMetrics that records OpenTelemetry metrics.
+//
+//   - cos_config.client.requests counts operations
+//   - cos_config.client.request.duration is a histogram of the duration of operations in seconds, including retries
+//   - cos_config.client.request.retries counts retries
+//   - cos_config.client.sent_bytes and cos_config.client.received_bytes count request and response body bytes
+type RequestMetrics struct {
+	requests      metric.Int64Counter
+	duration      metric.Float64Histogram
+	retries       metric.Int64Counter
+	sentBytes     metric.Int64Counter
+	receivedBytes metric.Int64Counter
+}
+
+// NewRequestMetrics creates the instruments with a meter of "provider", or of the global meter provider if it is nil.
+func NewRequestMetrics(provider metric.MeterProvider) (metrics *RequestMetrics, err error) {
+	if provider == nil {
+		provider = otel.GetMeterProvider()
+	}
+	meter := provider.Meter(ScopeName, metric.WithInstrumentationVersion(common.Version))
+	metrics = &RequestMetrics{}
+	metrics.requests, err = meter.Int64Counter("cos_config.client.requests",
+		metric.WithDescription("Operations sent by the Resource Configuration client."), metric.WithUnit("{request}"))
+	if err == nil {
+		metrics.duration, err = meter.Float64Histogram("cos_config.client.request.duration",
+			metric.WithDescription("Duration of the operations sent by the Resource Configuration client, including retries."), metric.WithUnit("s"))
+	}
+	if err == nil {
+		metrics.retries, err = meter.Int64Counter("cos_config.client.request.retries",
+			metric.WithDescription("Retries of the operations sent by the Resource Configuration client."), metric.WithUnit("{retry}"))
+	}
+	if err == nil {
+		metrics.sentBytes, err = meter.Int64Counter("cos_config.client.sent_bytes",
+			metric.WithDescription("Request body bytes sent by the Resource Configuration client."), metric.WithUnit("By"))
+	}
+	if err == nil {
+		metrics.receivedBytes, err = meter.Int64Counter("cos_config.client.received_bytes",
+			metric.WithDescription("Response body bytes received by the Resource Configuration client."), metric.WithUnit("By"))
+	}
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "metrics-instrument-error", common.GetComponentInfo())
+	}
+	return metrics, nil
+}
+
+// ObserveRequest records "observation".
+func (metrics *RequestMetrics) ObserveRequest(ctx context.Context, observation *rc.RequestObservation) {
+	operation := metric.WithAttributes(AttributeOperation.String(observation.OperationID))
+	attributes := []attribute.KeyValue{
+		AttributeOperation.String(observation.OperationID),
+		AttributeMethod.String(observation.Method),
+	}
+	if observation.StatusCode != 0 {
+		attributes = append(attributes, AttributeStatusCode.Int(observation.StatusCode))
+	}
+	if observation.Err != nil {
+		errorType := "error"
+		if observation.StatusCode != 0 {
+			errorType = strconv.Itoa(observation.StatusCode)
+		}
+		attributes = append(attributes, AttributeErrorType.String(errorType))
+	}
+	metrics.requests.Add(ctx, 1, metric.WithAttributes(attributes...))
+	metrics.duration.Record(ctx, observation.Duration.Seconds(), metric.WithAttributes(attributes...))
+	metrics.retries.Add(ctx, int64(observation.Retries), operation)
+	metrics.sentBytes.Add(ctx, observation.BytesSent, operation)
+	metrics.receivedBytes.Add(ctx, observation.BytesReceived, operation)
+}
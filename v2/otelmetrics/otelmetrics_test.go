@@ -0,0 +1,86 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package otelmetrics
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+	rc "github.com/IBM/ibm-cos-sdk-go-config/v2/resourceconfigurationv1"
+)
+
+func TestRequestMetrics(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	metrics, err := NewRequestMetrics(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)))
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	metrics.ObserveRequest(ctx, &rc.RequestObservation{OperationID: "GetBucketConfig", Method: "GET", StatusCode: 200,
+		Duration: 20 * time.Millisecond, Retries: 2, BytesReceived: 300})
+	metrics.ObserveRequest(ctx, &rc.RequestObservation{OperationID: "GetBucketConfig", Method: "GET", StatusCode: 404,
+		Duration: time.Millisecond, Err: errors.New("not found")})
+	metrics.ObserveRequest(ctx, &rc.RequestObservation{OperationID: "UpdateBucketConfig", Method: "PATCH",
+		Duration: time.Second, BytesSent: 40, Err: errors.New("connection refused")})
+
+	var data metricdata.ResourceMetrics
+	assert.NoError(t, reader.Collect(ctx, &data))
+	assert.Len(t, data.ScopeMetrics, 1)
+	assert.Equal(t, ScopeName, data.ScopeMetrics[0].Scope.Name)
+
+	sums := map[string]map[attribute.Distinct]int64{}
+	var histogram metricdata.Histogram[float64]
+	for _, m := range data.ScopeMetrics[0].Metrics {
+		switch d := m.Data.(type) {
+		case metricdata.Sum[int64]:
+			sums[m.Name] = map[attribute.Distinct]int64{}
+			for _, point := range d.DataPoints {
+				sums[m.Name][point.Attributes.Equivalent()] = point.Value
+			}
+		case metricdata.Histogram[float64]:
+			histogram = d
+		}
+	}
+
+	ok := attribute.NewSet(AttributeOperation.String("GetBucketConfig"), AttributeMethod.String("GET"), AttributeStatusCode.Int(200))
+	notFound := attribute.NewSet(AttributeOperation.String("GetBucketConfig"), AttributeMethod.String("GET"), AttributeStatusCode.Int(404),
+		AttributeErrorType.String("404"))
+	noResponse := attribute.NewSet(AttributeOperation.String("UpdateBucketConfig"), AttributeMethod.String("PATCH"),
+		AttributeErrorType.String("error"))
+	assert.Equal(t, map[attribute.Distinct]int64{ok.Equivalent(): 1, notFound.Equivalent(): 1, noResponse.Equivalent(): 1},
+		sums["cos_config.client.requests"])
+
+	getSet := attribute.NewSet(AttributeOperation.String("GetBucketConfig"))
+	updateSet := attribute.NewSet(AttributeOperation.String("UpdateBucketConfig"))
+	get, update := getSet.Equivalent(), updateSet.Equivalent()
+	assert.Equal(t, map[attribute.Distinct]int64{get: 2, update: 0}, sums["cos_config.client.request.retries"])
+	assert.Equal(t, map[attribute.Distinct]int64{get: 0, update: 40}, sums["cos_config.client.sent_bytes"])
+	assert.Equal(t, map[attribute.Distinct]int64{get: 300, update: 0}, sums["cos_config.client.received_bytes"])
+
+	assert.Len(t, histogram.DataPoints, 3)
+	for _, point := range histogram.DataPoints {
+		if point.Attributes.Equivalent() == noResponse.Equivalent() {
+			assert.Equal(t, 1.0, point.Sum)
+		}
+	}
+}
@@ -0,0 +1,153 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package prometheusmetrics reports the request metrics of a Resource Configuration client as Prometheus metrics.
+//
+//	metrics, err := prometheusmetrics.NewRequestMetrics(prometheus.DefaultRegisterer)
+//	if err == nil {
+//		service.SetRequestMetrics(metrics)
+//	}
+package prometheusmetrics
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strconv"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/ibm-cos-sdk-go-config/v2/common"
+	rc "github.com/IBM/ibm-cos-sdk-go-config/v2/resourceconfigurationv1"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const namespace = "cos_config_client"
+
+// RequestMetrics : A resourceconfigurationv1.RequestMetrics that updates Prometheus metrics. Every metric has an
+// "operation" label with the operation ID, such as "CreateRestore".
+//
+//   - cos_config_client_requests_total counts operations by operation, method and status, the HTTP status code or
+//     "error" when no response was received
+//   - cos_config_client_request_duration_seconds is a histogram of the duration of operations, including retries
+//   - cos_config_client_request_retries_total counts retries
+//   - cos_config_client_sent_bytes_total and cos_config_client_received_bytes_total count request and response body bytes
+type RequestMetrics struct {
+	requests      *prometheus.CounterVec
+	duration      *prometheus.HistogramVec
+	retries       *prometheus.CounterVec
+	sentBytes     *prometheus.CounterVec
+	receivedBytes *prometheus.CounterVec
+}
+
+// NewRequestMetrics creates the metrics and registers them with "registerer", or prometheus.DefaultRegisterer if it
+// is nil. Metrics that are already registered, by an earlier call for another client, are shared with it. If a metric
+// cannot be registered, none of those registered by this call are left behind.
+func NewRequestMetrics(registerer prometheus.Registerer) (*RequestMetrics, error) {
+	if registerer == nil {
+		registerer = prometheus.DefaultRegisterer
+	}
+	metrics := &RequestMetrics{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "requests_total",
+			Help:      "Operations sent by the Resource Configuration client.",
+		}, []string{"operation", "method", "status"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "request_duration_seconds",
+			Help:      "Duration of the operations sent by the Resource Configuration client, including retries.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"operation"}),
+		retries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "request_retries_total",
+			Help:      "Retries of the operations sent by the Resource Configuration client.",
+		}, []string{"operation"}),
+		sentBytes: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "sent_bytes_total",
+			Help:      "Request body bytes sent by the Resource Configuration client.",
+		}, []string{"operation"}),
+		receivedBytes: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "received_bytes_total",
+			Help:      "Response body bytes received by the Resource Configuration client.",
+		}, []string{"operation"}),
+	}
+	r := &registration{registerer: registerer}
+	metrics.requests = r.register(metrics.requests).(*prometheus.CounterVec)
+	metrics.duration = r.register(metrics.duration).(*prometheus.HistogramVec)
+	metrics.retries = r.register(metrics.retries).(*prometheus.CounterVec)
+	metrics.sentBytes = r.register(metrics.sentBytes).(*prometheus.CounterVec)
+	metrics.receivedBytes = r.register(metrics.receivedBytes).(*prometheus.CounterVec)
+	if r.err != nil {
+		r.rollback()
+		return nil, core.SDKErrorf(r.err, "", "metrics-register-error", common.GetComponentInfo())
+	}
+	return metrics, nil
+}
+
+// registration registers collectors until one fails.
+type registration struct {
+	registerer prometheus.Registerer
+
+	// The collectors registered so far, not counting those already registered before.
+	registered []prometheus.Collector
+
+	// The first registration failure.
+	err error
+}
+
+// register registers "collector" and returns it, or returns the collector of the same type that was already
+// registered in its place. After a failure, collectors are returned without being registered.
+func (r *registration) register(collector prometheus.Collector) prometheus.Collector {
+	if r.err != nil {
+		return collector
+	}
+	err := r.registerer.Register(collector)
+	var alreadyRegistered prometheus.AlreadyRegisteredError
+	switch {
+	case err == nil:
+		r.registered = append(r.registered, collector)
+	case errors.As(err, &alreadyRegistered) && reflect.TypeOf(alreadyRegistered.ExistingCollector) == reflect.TypeOf(collector):
+		return alreadyRegistered.ExistingCollector
+	default:
+		r.err = err
+	}
+	return collector
+}
+
+// rollback unregisters the collectors registered so far.
+func (r *registration) rollback() {
+	for _, collector := range r.registered {
+		r.registerer.Unregister(collector)
+	}
+	r.registered = nil
+}
+
+// ObserveRequest updates the metrics with "observation".
+func (metrics *RequestMetrics) ObserveRequest(_ context.Context, observation *rc.RequestObservation) {
+	status := "error"
+	if observation.StatusCode != 0 {
+		status = strconv.Itoa(observation.StatusCode)
+	}
+	operation := observation.OperationID
+	metrics.requests.WithLabelValues(operation, observation.Method, status).Inc()
+	metrics.duration.WithLabelValues(operation).Observe(observation.Duration.Seconds())
+	metrics.retries.WithLabelValues(operation).Add(float64(observation.Retries))
+	metrics.sentBytes.WithLabelValues(operation).Add(float64(observation.BytesSent))
+	metrics.receivedBytes.WithLabelValues(operation).Add(float64(observation.BytesReceived))
+}
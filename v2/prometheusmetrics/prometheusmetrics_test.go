@@ -0,0 +1,90 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package prometheusmetrics
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	rc "github.com/IBM/ibm-cos-sdk-go-config/v2/resourceconfigurationv1"
+)
+
+func TestRequestMetrics(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	metrics, err := NewRequestMetrics(registry)
+	assert.NoError(t, err)
+
+	metrics.ObserveRequest(context.Background(), &rc.RequestObservation{OperationID: "GetBucketConfig", Method: "GET", StatusCode: 200,
+		Duration: 20 * time.Millisecond, Retries: 2, BytesReceived: 300})
+	metrics.ObserveRequest(context.Background(), &rc.RequestObservation{OperationID: "UpdateBucketConfig", Method: "PATCH",
+		Duration: time.Second, BytesSent: 40})
+
+	expected := `
+# HELP cos_config_client_requests_total Operations sent by the Resource Configuration client.
+# TYPE cos_config_client_requests_total counter
+cos_config_client_requests_total{method="GET",operation="GetBucketConfig",status="200"} 1
+cos_config_client_requests_total{method="PATCH",operation="UpdateBucketConfig",status="error"} 1
+# HELP cos_config_client_request_retries_total Retries of the operations sent by the Resource Configuration client.
+# TYPE cos_config_client_request_retries_total counter
+cos_config_client_request_retries_total{operation="GetBucketConfig"} 2
+cos_config_client_request_retries_total{operation="UpdateBucketConfig"} 0
+# HELP cos_config_client_sent_bytes_total Request body bytes sent by the Resource Configuration client.
+# TYPE cos_config_client_sent_bytes_total counter
+cos_config_client_sent_bytes_total{operation="GetBucketConfig"} 0
+cos_config_client_sent_bytes_total{operation="UpdateBucketConfig"} 40
+# HELP cos_config_client_received_bytes_total Response body bytes received by the Resource Configuration client.
+# TYPE cos_config_client_received_bytes_total counter
+cos_config_client_received_bytes_total{operation="GetBucketConfig"} 300
+cos_config_client_received_bytes_total{operation="UpdateBucketConfig"} 0
+`
+	assert.NoError(t, testutil.GatherAndCompare(registry, strings.NewReader(expected),
+		"cos_config_client_requests_total", "cos_config_client_request_retries_total",
+		"cos_config_client_sent_bytes_total", "cos_config_client_received_bytes_total"))
+	assert.Equal(t, 2, testutil.CollectAndCount(metrics.duration))
+
+	// A second client shares the metrics of the first.
+	shared, err := NewRequestMetrics(registry)
+	assert.NoError(t, err)
+	shared.ObserveRequest(context.Background(), &rc.RequestObservation{OperationID: "GetBucketConfig", Method: "GET", StatusCode: 200})
+	assert.Equal(t, float64(2), testutil.ToFloat64(metrics.requests.WithLabelValues("GetBucketConfig", "GET", "200")))
+}
+
+func TestRequestMetricsRegisterError(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	// A metric of the same name with other labels cannot be registered alongside.
+	conflict := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "cos_config_client_request_retries_total",
+		Help: "Retries of the operations sent by the Resource Configuration client.",
+	}, []string{"client"})
+	assert.NoError(t, registry.Register(conflict))
+
+	metrics, err := NewRequestMetrics(registry)
+	assert.Error(t, err)
+	assert.Nil(t, metrics)
+	// The metrics registered before the conflict are unregistered again.
+	families, err := registry.Gather()
+	assert.NoError(t, err)
+	for _, family := range families {
+		assert.Equal(t, "cos_config_client_request_retries_total", family.GetName())
+	}
+}
@@ -0,0 +1,478 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourceconfigurationv1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/ibm-cos-sdk-go-config/v2/common"
+	"github.com/go-openapi/strfmt"
+)
+
+// DefaultBulkRestoreConcurrency is the number of restores a bulk restore runs at once when it is not given a limit.
+const DefaultBulkRestoreConcurrency = 4
+
+// Constants associated with the BulkRestoreEvent.Phase and BulkRestoreResult.Status properties.
+// The progress of one restore of a bulk restore.
+const (
+	// The recovery range to restore from was chosen.
+	BulkRestorePhase_Resolved = "resolved"
+
+	// The restore was created.
+	BulkRestorePhase_Started = "started"
+
+	// The status of the restore was checked.
+	BulkRestorePhase_Progress = "progress"
+
+	// The restore completed.
+	BulkRestorePhase_Complete = "complete"
+
+	// The restore could not be resolved or started, or it failed.
+	BulkRestorePhase_Failed = "failed"
+
+	// The bulk restore was canceled before the restore completed. A restore that was already started keeps running.
+	BulkRestorePhase_Canceled = "canceled"
+)
+
+// BulkRestoreItem : A bucket to restore as part of a bulk restore.
+type BulkRestoreItem struct {
+	// The CRN of the bucket whose backups are restored.
+	SourceResourceCrn *string `json:"source_resource_crn" validate:"required,ne="`
+
+	// The CRN of the bucket to restore into.
+	TargetResourceCrn *string `json:"target_resource_crn" validate:"required,ne="`
+
+	// The time to restore the bucket to.
+	PointInTime *strfmt.DateTime `json:"point_in_time" validate:"required"`
+}
+
+// BulkRestoreOptions : The RunBulkRestore options.
+type BulkRestoreOptions struct {
+	// Name of the service_instance whose BackupVaults are searched for recovery ranges.
+	ServiceInstanceID *string `json:"service_instance_id" validate:"required,ne="`
+
+	// The buckets to restore.
+	Items []BulkRestoreItem `json:"items" validate:"required,min=1,dive"`
+
+	// The maximum number of restores that are resolved, started or tracked at once. Defaults to
+	// DefaultBulkRestoreConcurrency.
+	Concurrency int
+
+	// The minimum time between two restores being started. Zero starts restores as soon as a slot is free.
+	StartInterval time.Duration
+
+	// The time to wait between status checks of a restore. Defaults to DefaultWaitPollInterval.
+	PollInterval time.Duration
+
+	// Invoked for every change in the progress of a restore. Calls are never made concurrently.
+	OnProgress func(event *BulkRestoreEvent)
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewBulkRestoreOptions : Instantiate BulkRestoreOptions
+func (*ResourceConfigurationV1) NewBulkRestoreOptions(serviceInstanceID string, items []BulkRestoreItem) *BulkRestoreOptions {
+	return &BulkRestoreOptions{
+		ServiceInstanceID: core.StringPtr(serviceInstanceID),
+		Items:             items,
+	}
+}
+
+// SetConcurrency : Allow user to set Concurrency
+func (_options *BulkRestoreOptions) SetConcurrency(concurrency int) *BulkRestoreOptions {
+	_options.Concurrency = concurrency
+	return _options
+}
+
+// SetStartInterval : Allow user to set StartInterval
+func (_options *BulkRestoreOptions) SetStartInterval(startInterval time.Duration) *BulkRestoreOptions {
+	_options.StartInterval = startInterval
+	return _options
+}
+
+// SetPollInterval : Allow user to set PollInterval
+func (_options *BulkRestoreOptions) SetPollInterval(pollInterval time.Duration) *BulkRestoreOptions {
+	_options.PollInterval = pollInterval
+	return _options
+}
+
+// SetOnProgress : Allow user to set OnProgress
+func (_options *BulkRestoreOptions) SetOnProgress(onProgress func(event *BulkRestoreEvent)) *BulkRestoreOptions {
+	_options.OnProgress = onProgress
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *BulkRestoreOptions) SetHeaders(param map[string]string) *BulkRestoreOptions {
+	options.Headers = param
+	return options
+}
+
+// BulkRestoreEvent : A change in the progress of one restore of a bulk restore.
+type BulkRestoreEvent struct {
+	// The position of the item in BulkRestoreOptions.Items.
+	Index int `json:"index"`
+
+	// The item being restored.
+	Item *BulkRestoreItem `json:"item"`
+
+	// The progress of the restore.
+	Phase string `json:"phase"`
+
+	// The name of the BackupVault restored from, once resolved.
+	BackupVaultName string `json:"backup_vault_name,omitempty"`
+
+	// The ID of the RecoveryRange restored from, once resolved.
+	RecoveryRangeID string `json:"recovery_range_id,omitempty"`
+
+	// The latest state of the restore, once started.
+	Restore *Restore `json:"restore,omitempty"`
+
+	// Why the restore failed.
+	Error string `json:"error,omitempty"`
+}
+
+// BulkRestoreResult : The outcome of one restore of a bulk restore.
+type BulkRestoreResult struct {
+	// The item that was restored.
+	Item BulkRestoreItem `json:"item"`
+
+	// The final phase of the restore: `complete`, `failed` or `canceled`.
+	Status string `json:"status"`
+
+	// The name of the BackupVault restored from, if resolved.
+	BackupVaultName string `json:"backup_vault_name,omitempty"`
+
+	// The ID of the RecoveryRange restored from, if resolved.
+	RecoveryRangeID string `json:"recovery_range_id,omitempty"`
+
+	// The final state of the restore, if started.
+	Restore *Restore `json:"restore,omitempty"`
+
+	// The time the restore was created.
+	StartedAt *strfmt.DateTime `json:"started_at,omitempty"`
+
+	// The time the restore completed or failed.
+	FinishedAt *strfmt.DateTime `json:"finished_at,omitempty"`
+
+	// Why the restore failed.
+	Error string `json:"error,omitempty"`
+}
+
+// BulkRestoreReport : The outcome of a bulk restore, in the order of BulkRestoreOptions.Items.
+type BulkRestoreReport struct {
+	Results []BulkRestoreResult `json:"results"`
+}
+
+// Completed returns the number of restores that completed.
+func (report *BulkRestoreReport) Completed() int {
+	completed := 0
+	for i := range report.Results {
+		if report.Results[i].Status == BulkRestorePhase_Complete {
+			completed++
+		}
+	}
+	return completed
+}
+
+// Failures returns the results of the restores that did not complete.
+func (report *BulkRestoreReport) Failures() []BulkRestoreResult {
+	failures := []BulkRestoreResult{}
+	for _, result := range report.Results {
+		if result.Status != BulkRestorePhase_Complete {
+			failures = append(failures, result)
+		}
+	}
+	return failures
+}
+
+// WriteJSON writes the report to "w" as indented JSON.
+func (report *BulkRestoreReport) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(report)
+}
+
+// WriteTable writes the report to "w" as a table with one row per restore.
+func (report *BulkRestoreReport) WriteTable(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "SOURCE\tTARGET\tSTATUS\tVAULT\tRANGE\tRESTORE\tDURATION\tERROR")
+	for _, result := range report.Results {
+		restoreID, duration := "-", "-"
+		if result.Restore != nil {
+			restoreID = orDash(result.Restore.RestoreID)
+		}
+		if result.StartedAt != nil && result.FinishedAt != nil {
+			duration = time.Time(*result.FinishedAt).Sub(time.Time(*result.StartedAt)).String()
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			orDash(result.Item.SourceResourceCrn), orDash(result.Item.TargetResourceCrn), result.Status,
+			orDash(&result.BackupVaultName), orDash(&result.RecoveryRangeID), restoreID, duration, orDash(&result.Error))
+	}
+	return tw.Flush()
+}
+
+// RunBulkRestore : Restore many buckets at once
+// For every item, finds the best recovery range that contains the point in time as ResolvePointInTime does, starts an
+// in-place restore to the target bucket and waits for it to complete or fail. At most Concurrency items are in
+// progress at once, and restores are started at least StartInterval apart. Every change in progress is reported to
+// OnProgress. The recovery ranges of each distinct source bucket are listed once, however many items restore it.
+//
+// A restore that cannot be resolved or started, or that fails, is recorded in the report and does not stop the
+// others. If the context is canceled, the items not yet complete are recorded as `canceled` and the report is returned
+// together with the error; restores already started keep running on the server.
+func (resourceConfiguration *ResourceConfigurationV1) RunBulkRestore(bulkRestoreOptions *BulkRestoreOptions) (result *BulkRestoreReport, err error) {
+	result, err = resourceConfiguration.RunBulkRestoreWithContext(context.Background(), bulkRestoreOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// RunBulkRestoreWithContext is an alternate form of the RunBulkRestore method which supports a Context parameter
+func (resourceConfiguration *ResourceConfigurationV1) RunBulkRestoreWithContext(ctx context.Context, bulkRestoreOptions *BulkRestoreOptions) (result *BulkRestoreReport, err error) {
+	err = core.ValidateNotNil(bulkRestoreOptions, "bulkRestoreOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(bulkRestoreOptions, "bulkRestoreOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	concurrency := bulkRestoreOptions.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultBulkRestoreConcurrency
+	}
+	run := &bulkRestoreRun{
+		service: resourceConfiguration,
+		options: bulkRestoreOptions,
+		limiter: &startLimiter{interval: bulkRestoreOptions.StartInterval},
+		results: make([]BulkRestoreResult, len(bulkRestoreOptions.Items)),
+	}
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < concurrency && w < len(bulkRestoreOptions.Items); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				run.restore(ctx, i)
+			}
+		}()
+	}
+	for i := range bulkRestoreOptions.Items {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	result = &BulkRestoreReport{Results: run.results}
+	if ctx.Err() != nil {
+		err = core.SDKErrorf(ctx.Err(), "", "bulk-restore-canceled", common.GetComponentInfo())
+	}
+	return
+}
+
+// bulkRestoreRun holds the state shared by the workers of one RunBulkRestore call.
+type bulkRestoreRun struct {
+	service *ResourceConfigurationV1
+	options *BulkRestoreOptions
+	limiter *startLimiter
+
+	// progressMutex serializes the calls to OnProgress.
+	progressMutex sync.Mutex
+
+	// results is indexed like options.Items; each worker writes only the entries of the items it was given.
+	results []BulkRestoreResult
+
+	// sourcesMutex guards sources.
+	sourcesMutex sync.Mutex
+
+	// sources holds the recovery ranges of each source CRN, listed by the first item that restores it.
+	sources map[string]*bulkRestoreSource
+}
+
+// bulkRestoreSource holds the recovery ranges of one source bucket.
+type bulkRestoreSource struct {
+	once   sync.Once
+	ranges []PointInTimeCandidate
+	err    error
+}
+
+// sourceRanges returns the recovery ranges of the bucket with the CRN "sourceResourceCrn", listing them on the first
+// call for that bucket. Concurrent calls for the same bucket wait for the first to finish.
+func (run *bulkRestoreRun) sourceRanges(ctx context.Context, sourceResourceCrn string) ([]PointInTimeCandidate, error) {
+	run.sourcesMutex.Lock()
+	if run.sources == nil {
+		run.sources = map[string]*bulkRestoreSource{}
+	}
+	source, ok := run.sources[sourceResourceCrn]
+	if !ok {
+		source = &bulkRestoreSource{}
+		run.sources[sourceResourceCrn] = source
+	}
+	run.sourcesMutex.Unlock()
+
+	source.once.Do(func() {
+		source.ranges, source.err = run.service.listSourceRecoveryRanges(ctx, *run.options.ServiceInstanceID, sourceResourceCrn, run.options.Headers)
+	})
+	return source.ranges, source.err
+}
+
+// restore resolves, starts and tracks the item at index "i", recording the outcome in results[i].
+func (run *bulkRestoreRun) restore(ctx context.Context, i int) {
+	item := &run.options.Items[i]
+	result := &run.results[i]
+	result.Item = *item
+	event := &BulkRestoreEvent{Index: i, Item: item}
+
+	fail := func(phase string, err error) {
+		result.Status = phase
+		result.Error = err.Error()
+		if result.StartedAt != nil {
+			result.FinishedAt = currentDateTime()
+		}
+		event.Phase = phase
+		event.Error = result.Error
+		run.report(event)
+	}
+
+	if ctx.Err() != nil {
+		fail(BulkRestorePhase_Canceled, ctx.Err())
+		return
+	}
+
+	ranges, err := run.sourceRanges(ctx, *item.SourceResourceCrn)
+	if err != nil && ctx.Err() != nil {
+		fail(BulkRestorePhase_Canceled, err)
+		return
+	}
+	if err != nil {
+		fail(BulkRestorePhase_Failed, err)
+		return
+	}
+	candidates := selectCandidates(ranges, time.Time(*item.PointInTime))
+	if len(candidates) == 0 {
+		fail(BulkRestorePhase_Failed, fmt.Errorf("no recovery range of %s contains %s", *item.SourceResourceCrn, item.PointInTime.String()))
+		return
+	}
+	candidate := candidates[0]
+	result.BackupVaultName = candidate.BackupVaultName
+	result.RecoveryRangeID = core.StringNilMapper(candidate.RecoveryRange.RecoveryRangeID)
+	event.BackupVaultName = result.BackupVaultName
+	event.RecoveryRangeID = result.RecoveryRangeID
+	event.Phase = BulkRestorePhase_Resolved
+	run.report(event)
+
+	if err = run.limiter.wait(ctx); err != nil {
+		fail(BulkRestorePhase_Canceled, err)
+		return
+	}
+	createRestoreOptions := candidate.NewCreateRestoreOptions(item.PointInTime, *item.TargetResourceCrn)
+	createRestoreOptions.SetHeaders(run.options.Headers)
+	restore, _, err := run.service.CreateRestoreWithContext(ctx, createRestoreOptions)
+	if err != nil && ctx.Err() != nil {
+		fail(BulkRestorePhase_Canceled, err)
+		return
+	}
+	if err != nil {
+		fail(BulkRestorePhase_Failed, err)
+		return
+	}
+	result.Restore = restore
+	result.StartedAt = currentDateTime()
+	event.Restore = restore
+	event.Phase = BulkRestorePhase_Started
+	run.report(event)
+
+	waitOptions := run.service.NewWaitForRestoreOptions(candidate.BackupVaultName, *restore.RestoreID)
+	waitOptions.SetPollInterval(run.options.PollInterval)
+	waitOptions.SetHeaders(run.options.Headers)
+	waitOptions.SetOnPoll(func(restore *Restore) {
+		result.Restore = restore
+		event.Restore = restore
+		event.Phase = BulkRestorePhase_Progress
+		run.report(event)
+	})
+	_, err = run.service.WaitForRestoreWithContext(ctx, waitOptions)
+	if err != nil && ctx.Err() != nil {
+		fail(BulkRestorePhase_Canceled, err)
+		return
+	}
+	if err != nil {
+		fail(BulkRestorePhase_Failed, err)
+		return
+	}
+	result.Status = BulkRestorePhase_Complete
+	result.FinishedAt = currentDateTime()
+	event.Phase = BulkRestorePhase_Complete
+	run.report(event)
+}
+
+// report passes a copy of "event" to OnProgress.
+func (run *bulkRestoreRun) report(event *BulkRestoreEvent) {
+	if run.options.OnProgress == nil {
+		return
+	}
+	eventCopy := *event
+	run.progressMutex.Lock()
+	defer run.progressMutex.Unlock()
+	run.options.OnProgress(&eventCopy)
+}
+
+// startLimiter spaces out the calls that pass through it by at least "interval".
+type startLimiter struct {
+	mutex    sync.Mutex
+	interval time.Duration
+	next     time.Time
+}
+
+// wait blocks until the caller may proceed, or until the context is done.
+func (limiter *startLimiter) wait(ctx context.Context) error {
+	if limiter.interval <= 0 {
+		return nil
+	}
+	limiter.mutex.Lock()
+	at := time.Now()
+	if limiter.next.After(at) {
+		at = limiter.next
+	}
+	limiter.next = at.Add(limiter.interval)
+	limiter.mutex.Unlock()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(time.Until(at)):
+		return nil
+	}
+}
+
+func currentDateTime() *strfmt.DateTime {
+	dateTime := strfmt.DateTime(time.Now().UTC())
+	return &dateTime
+}
@@ -0,0 +1,225 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourceconfigurationv1_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/ibm-cos-sdk-go-config/v2/resourceconfigurationv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ResourceConfigurationV1 bulk restore`, func() {
+	const crnPrefix = "crn:v1:bluemix:public:cloud-object-storage:global:a/3bf0d9003abfb5d29761c3e97696b71c:d6f04d83-6c4f-4a62-a165-696756d63903:"
+	var testServer *httptest.Server
+	var resourceConfigurationService *resourceconfigurationv1.ResourceConfigurationV1
+	var mutex sync.Mutex
+	var polls map[string]int
+	var starts []time.Time
+	var listings map[string]int
+	var cancelAt string
+	var cancel context.CancelFunc
+	BeforeEach(func() {
+		polls = map[string]int{}
+		starts = nil
+		listings = map[string]int{}
+		cancelAt = ""
+		cancel = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			mutex.Lock()
+			defer mutex.Unlock()
+			res.Header().Set("Content-type", "application/json")
+			if req.Method+" "+req.URL.EscapedPath() == cancelAt {
+				cancel()
+				res.WriteHeader(500)
+				return
+			}
+			switch path := req.URL.EscapedPath(); {
+			case path == "/backup_vaults":
+				listings[path]++
+				fmt.Fprint(res, `{"backup_vaults": ["vault-a"]}`)
+			case path == "/backup_vaults/vault-a/recovery_ranges":
+				source := req.URL.Query().Get("source_resource_crn")
+				listings[source]++
+				if source == crnPrefix+"bucket:unprotected" {
+					fmt.Fprint(res, `{"recovery_ranges": []}`)
+					return
+				}
+				name := strings.TrimPrefix(source, crnPrefix+"bucket:")
+				fmt.Fprintf(res, `{"recovery_ranges": [{"recovery_range_id": "range-%s", "range_start_time": "2025-01-01T00:00:00.000Z", "range_end_time": "2025-03-01T00:00:00.000Z"}]}`, name)
+			case path == "/backup_vaults/vault-a/restores" && req.Method == "POST":
+				var body map[string]interface{}
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+				Expect(body["restore_type"]).To(Equal("in_place"))
+				starts = append(starts, time.Now())
+				id := "restore-" + strings.TrimPrefix(body["recovery_range_id"].(string), "range-")
+				res.WriteHeader(201)
+				fmt.Fprintf(res, `{"restore_id": "%s", "restore_status": "initializing"}`, id)
+			case strings.HasPrefix(path, "/backup_vaults/vault-a/restores/"):
+				id := strings.TrimPrefix(path, "/backup_vaults/vault-a/restores/")
+				polls[id]++
+				switch {
+				case id == "restore-broken":
+					fmt.Fprintf(res, `{"restore_id": "%s", "restore_status": "failed", "error_cause": "target bucket is locked"}`, id)
+				case polls[id] < 2:
+					fmt.Fprintf(res, `{"restore_id": "%s", "restore_status": "running", "restore_percent_progress": 50}`, id)
+				default:
+					fmt.Fprintf(res, `{"restore_id": "%s", "restore_status": "complete", "restore_percent_progress": 100}`, id)
+				}
+			default:
+				Fail("unexpected request " + req.Method + " " + path)
+			}
+		}))
+		var serviceErr error
+		resourceConfigurationService, serviceErr = resourceconfigurationv1.NewResourceConfigurationV1(&resourceconfigurationv1.ResourceConfigurationV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+	item := func(source string, target string) resourceconfigurationv1.BulkRestoreItem {
+		return resourceconfigurationv1.BulkRestoreItem{
+			SourceResourceCrn: core.StringPtr(crnPrefix + "bucket:" + source),
+			TargetResourceCrn: core.StringPtr(crnPrefix + "bucket:" + target),
+			PointInTime:       CreateMockDateTime("2025-02-01T00:00:00.000Z"),
+		}
+	}
+	It(`Invoke RunBulkRestore successfully`, func() {
+		items := []resourceconfigurationv1.BulkRestoreItem{
+			item("one", "one"),
+			item("unprotected", "unprotected"),
+			item("broken", "broken"),
+			item("two", "two-copy"),
+		}
+		phases := map[int][]string{}
+		bulkRestoreOptionsModel := resourceConfigurationService.NewBulkRestoreOptions("testInstance", items)
+		bulkRestoreOptionsModel.SetConcurrency(2)
+		bulkRestoreOptionsModel.SetStartInterval(20 * time.Millisecond)
+		bulkRestoreOptionsModel.SetPollInterval(time.Millisecond)
+		bulkRestoreOptionsModel.SetOnProgress(func(event *resourceconfigurationv1.BulkRestoreEvent) {
+			phases[event.Index] = append(phases[event.Index], event.Phase)
+		})
+		report, operationErr := resourceConfigurationService.RunBulkRestore(bulkRestoreOptionsModel)
+		Expect(operationErr).To(BeNil())
+		Expect(report.Results).To(HaveLen(4))
+		Expect(report.Completed()).To(Equal(2))
+
+		one := report.Results[0]
+		Expect(one.Status).To(Equal(resourceconfigurationv1.BulkRestorePhase_Complete))
+		Expect(one.BackupVaultName).To(Equal("vault-a"))
+		Expect(one.RecoveryRangeID).To(Equal("range-one"))
+		Expect(*one.Restore.RestoreStatus).To(Equal("complete"))
+		Expect(one.StartedAt).ToNot(BeNil())
+		Expect(one.FinishedAt).ToNot(BeNil())
+		Expect(phases[0]).To(Equal([]string{"resolved", "started", "progress", "progress", "complete"}))
+
+		Expect(report.Results[1].Status).To(Equal(resourceconfigurationv1.BulkRestorePhase_Failed))
+		Expect(report.Results[1].Error).To(ContainSubstring("no recovery range"))
+		Expect(report.Results[1].Restore).To(BeNil())
+		Expect(phases[1]).To(Equal([]string{"failed"}))
+
+		Expect(report.Results[2].Status).To(Equal(resourceconfigurationv1.BulkRestorePhase_Failed))
+		Expect(report.Results[2].Error).To(ContainSubstring("target bucket is locked"))
+		Expect(phases[2]).To(Equal([]string{"resolved", "started", "progress", "failed"}))
+
+		Expect(*report.Results[3].Item.TargetResourceCrn).To(HaveSuffix("bucket:two-copy"))
+		Expect(report.Results[3].Status).To(Equal(resourceconfigurationv1.BulkRestorePhase_Complete))
+
+		failures := report.Failures()
+		Expect(failures).To(HaveLen(2))
+
+		Expect(starts).To(HaveLen(3))
+		Expect(starts[2].Sub(starts[0])).To(BeNumerically(">=", 30*time.Millisecond))
+
+		var table bytes.Buffer
+		Expect(report.WriteTable(&table)).To(Succeed())
+		lines := strings.Split(strings.TrimSpace(table.String()), "\n")
+		Expect(lines).To(HaveLen(5))
+		Expect(lines[0]).To(MatchRegexp(`^SOURCE\s+TARGET\s+STATUS\s+VAULT\s+RANGE\s+RESTORE\s+DURATION\s+ERROR$`))
+		Expect(lines[2]).To(MatchRegexp(`failed\s+-\s+-\s+-\s+-\s+no recovery range`))
+	})
+	It(`Invoke RunBulkRestore with several items of the same source`, func() {
+		items := []resourceconfigurationv1.BulkRestoreItem{
+			item("one", "one"),
+			item("one", "one-copy"),
+			item("two", "two"),
+			item("one", "one-other"),
+		}
+		bulkRestoreOptionsModel := resourceConfigurationService.NewBulkRestoreOptions("testInstance", items)
+		bulkRestoreOptionsModel.SetConcurrency(4)
+		bulkRestoreOptionsModel.SetPollInterval(time.Millisecond)
+		report, operationErr := resourceConfigurationService.RunBulkRestore(bulkRestoreOptionsModel)
+		Expect(operationErr).To(BeNil())
+		Expect(report.Completed()).To(Equal(4))
+		Expect(report.Results[1].RecoveryRangeID).To(Equal("range-one"))
+		Expect(listings).To(Equal(map[string]int{
+			"/backup_vaults":         2,
+			crnPrefix + "bucket:one": 1,
+			crnPrefix + "bucket:two": 1,
+		}))
+	})
+	It(`Invoke RunBulkRestore with a canceled context`, func() {
+		var ctx context.Context
+		ctx, cancel = context.WithCancel(context.Background())
+		cancel()
+		bulkRestoreOptionsModel := resourceConfigurationService.NewBulkRestoreOptions("testInstance", []resourceconfigurationv1.BulkRestoreItem{item("one", "one")})
+		report, operationErr := resourceConfigurationService.RunBulkRestoreWithContext(ctx, bulkRestoreOptionsModel)
+		Expect(operationErr).ToNot(BeNil())
+		Expect(report.Results[0].Status).To(Equal(resourceconfigurationv1.BulkRestorePhase_Canceled))
+		Expect(starts).To(BeEmpty())
+
+		for _, at := range []string{"GET /backup_vaults", "POST /backup_vaults/vault-a/restores"} {
+			cancelAt = at
+			ctx, cancel = context.WithCancel(context.Background())
+			report, operationErr = resourceConfigurationService.RunBulkRestoreWithContext(ctx, bulkRestoreOptionsModel)
+			Expect(operationErr).ToNot(BeNil())
+			Expect(report.Results[0].Status).To(Equal(resourceconfigurationv1.BulkRestorePhase_Canceled), at)
+			Expect(report.Results[0].Error).ToNot(BeEmpty())
+		}
+		Expect(starts).To(BeEmpty())
+	})
+	It(`Invoke RunBulkRestore with error`, func() {
+		report, operationErr := resourceConfigurationService.RunBulkRestore(nil)
+		Expect(operationErr).ToNot(BeNil())
+		Expect(report).To(BeNil())
+
+		report, operationErr = resourceConfigurationService.RunBulkRestore(resourceConfigurationService.NewBulkRestoreOptions("testInstance", nil))
+		Expect(operationErr).ToNot(BeNil())
+		Expect(report).To(BeNil())
+
+		invalid := item("one", "one")
+		invalid.PointInTime = nil
+		report, operationErr = resourceConfigurationService.RunBulkRestore(resourceConfigurationService.NewBulkRestoreOptions("testInstance", []resourceconfigurationv1.BulkRestoreItem{invalid}))
+		Expect(operationErr).ToNot(BeNil())
+		Expect(report).To(BeNil())
+	})
+})
@@ -0,0 +1,380 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourceconfigurationv1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/ibm-cos-sdk-go-config/v2/common"
+	"github.com/IBM/ibm-cos-sdk-go-config/v2/crn"
+	"github.com/go-openapi/strfmt"
+)
+
+// DefaultCoverageStaleAfter is the recovery point gap after which a recovery range is reported as stale when a
+// coverage report is not given one.
+const DefaultCoverageStaleAfter = 24 * time.Hour
+
+// Constants associated with the BucketCoverage.Flags and PolicyCoverage.Flags properties.
+// The problems found by a coverage report.
+const (
+	// The bucket has no backup policy.
+	CoverageFlag_Unprotected = "unprotected"
+
+	// The backup policy is `action_needed`, `degraded` or `failed`.
+	CoverageFlag_Unhealthy = "unhealthy"
+
+	// The latest recovery range ends longer ago than the stale threshold, or there is no recovery range.
+	CoverageFlag_Stale = "stale"
+
+	// The coverage could not be determined.
+	CoverageFlag_Error = "error"
+)
+
+// CoverageReportOptions : The GetCoverageReport options.
+type CoverageReportOptions struct {
+	// The names of the buckets to report on.
+	Buckets []string `json:"buckets" validate:"required,min=1,dive,required"`
+
+	// The recovery point gap after which a recovery range is stale. Defaults to DefaultCoverageStaleAfter.
+	StaleAfter time.Duration
+
+	// The time the recovery point gap is measured from. Defaults to the current time.
+	Now time.Time
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewCoverageReportOptions : Instantiate CoverageReportOptions
+func (*ResourceConfigurationV1) NewCoverageReportOptions(buckets []string) *CoverageReportOptions {
+	return &CoverageReportOptions{
+		Buckets: buckets,
+	}
+}
+
+// SetStaleAfter : Allow user to set StaleAfter
+func (_options *CoverageReportOptions) SetStaleAfter(staleAfter time.Duration) *CoverageReportOptions {
+	_options.StaleAfter = staleAfter
+	return _options
+}
+
+// SetNow : Allow user to set Now
+func (_options *CoverageReportOptions) SetNow(now time.Time) *CoverageReportOptions {
+	_options.Now = now
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *CoverageReportOptions) SetHeaders(param map[string]string) *CoverageReportOptions {
+	options.Headers = param
+	return options
+}
+
+// CoverageReport : The backup coverage of a set of buckets.
+type CoverageReport struct {
+	// The time the recovery point gaps are measured from.
+	GeneratedAt *strfmt.DateTime `json:"generated_at"`
+
+	// The recovery point gap after which a recovery range is stale, in seconds.
+	StaleAfterSeconds int64 `json:"stale_after_seconds"`
+
+	// The coverage of each bucket, in the order they were requested.
+	Buckets []BucketCoverage `json:"buckets"`
+}
+
+// BucketCoverage : The backup coverage of one bucket.
+type BucketCoverage struct {
+	// The name of the bucket.
+	Bucket string `json:"bucket"`
+
+	// The CRN of the bucket.
+	BucketCrn string `json:"bucket_crn,omitempty"`
+
+	// The backup policies of the bucket.
+	Policies []PolicyCoverage `json:"policies"`
+
+	// The problems of the bucket itself: `unprotected` or `error`.
+	Flags []string `json:"flags,omitempty"`
+
+	// Why the coverage could not be determined.
+	Error string `json:"error,omitempty"`
+}
+
+// PolicyCoverage : The backup coverage of one backup policy.
+type PolicyCoverage struct {
+	// The backup policy.
+	Policy *BackupPolicy `json:"policy"`
+
+	// The name of the backup vault the policy targets.
+	BackupVaultName string `json:"backup_vault_name,omitempty"`
+
+	// The latest recovery range of the bucket in the backup vault.
+	LatestRecoveryRange *RecoveryRange `json:"latest_recovery_range,omitempty"`
+
+	// The time since the end of the latest recovery range, in seconds.
+	RecoveryPointGapSeconds *int64 `json:"recovery_point_gap_seconds,omitempty"`
+
+	// The problems of the policy: `unhealthy`, `stale` or `error`.
+	Flags []string `json:"flags,omitempty"`
+
+	// Why the recovery range could not be determined.
+	Error string `json:"error,omitempty"`
+}
+
+// RecoveryPointGap returns the time since the end of the latest recovery range, or false if there is none.
+func (coverage *PolicyCoverage) RecoveryPointGap() (time.Duration, bool) {
+	if coverage.RecoveryPointGapSeconds == nil {
+		return 0, false
+	}
+	return time.Duration(*coverage.RecoveryPointGapSeconds) * time.Second, true
+}
+
+// Flagged returns the buckets that have a problem or a policy with a problem.
+func (report *CoverageReport) Flagged() (flagged []BucketCoverage) {
+	for _, bucket := range report.Buckets {
+		if len(bucket.Flags) > 0 {
+			flagged = append(flagged, bucket)
+			continue
+		}
+		for _, policy := range bucket.Policies {
+			if len(policy.Flags) > 0 {
+				flagged = append(flagged, bucket)
+				break
+			}
+		}
+	}
+	return
+}
+
+// WriteJSON writes the report to "w" as indented JSON.
+func (report *CoverageReport) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(report)
+}
+
+// WriteTable writes the report to "w" as aligned columns, one row per backup policy and one for each bucket without
+// policies. The FLAGS column lists the problems found.
+func (report *CoverageReport) WriteTable(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 3, ' ', 0)
+	fmt.Fprintln(tw, "BUCKET\tPOLICY\tSTATUS\tINITIAL SYNC\tVAULT\tLATEST RANGE END\tRECOVERY POINT GAP\tFLAGS")
+	for _, bucket := range report.Buckets {
+		if len(bucket.Policies) == 0 {
+			fmt.Fprintf(tw, "%s\t-\t-\t-\t-\t-\t-\t%s\n", bucket.Bucket, strings.Join(bucket.Flags, ","))
+			continue
+		}
+		for _, policy := range bucket.Policies {
+			progress := "-"
+			if policy.Policy.InitialSyncProgress != nil {
+				progress = strconv.FormatFloat(*policy.Policy.InitialSyncProgress, 'f', -1, 64) + "%"
+			}
+			rangeEnd := "-"
+			if policy.LatestRecoveryRange != nil && policy.LatestRecoveryRange.RangeEndTime != nil {
+				rangeEnd = time.Time(*policy.LatestRecoveryRange.RangeEndTime).UTC().Format(time.RFC3339)
+			}
+			gap := "-"
+			if duration, ok := policy.RecoveryPointGap(); ok {
+				gap = duration.String()
+			}
+			flags := append(append([]string{}, bucket.Flags...), policy.Flags...)
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", bucket.Bucket, orDash(policy.Policy.PolicyName),
+				orDash(policy.Policy.PolicyStatus), progress, orDash(&policy.BackupVaultName), rangeEnd, gap, strings.Join(flags, ","))
+		}
+	}
+	return tw.Flush()
+}
+
+func orDash(value *string) string {
+	if value == nil || *value == "" {
+		return "-"
+	}
+	return *value
+}
+
+// GetCoverageReport : Report the backup coverage of buckets
+// Gathers, for each bucket, its backup policies with their status and initial sync progress, their target backup
+// vaults, and the latest recovery range of the bucket in each vault, and calculates the recovery point gap: the time
+// since the end of that range.
+//
+// Buckets without policies are flagged `unprotected`, policies that are `action_needed`, `degraded` or `failed` are
+// flagged `unhealthy`, and policies whose latest recovery range ends longer ago than StaleAfter, or that have no
+// recovery range, are flagged `stale`. Failures to read a bucket or a vault are recorded in the report and flagged
+// `error` rather than returned, so that one inaccessible bucket does not hide the others.
+func (resourceConfiguration *ResourceConfigurationV1) GetCoverageReport(coverageReportOptions *CoverageReportOptions) (result *CoverageReport, err error) {
+	result, err = resourceConfiguration.GetCoverageReportWithContext(context.Background(), coverageReportOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetCoverageReportWithContext is an alternate form of the GetCoverageReport method which supports a Context parameter
+func (resourceConfiguration *ResourceConfigurationV1) GetCoverageReportWithContext(ctx context.Context, coverageReportOptions *CoverageReportOptions) (result *CoverageReport, err error) {
+	err = core.ValidateNotNil(coverageReportOptions, "coverageReportOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(coverageReportOptions, "coverageReportOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	now := coverageReportOptions.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+	staleAfter := coverageReportOptions.StaleAfter
+	if staleAfter <= 0 {
+		staleAfter = DefaultCoverageStaleAfter
+	}
+	generatedAt := strfmt.DateTime(now)
+	result = &CoverageReport{
+		GeneratedAt:       &generatedAt,
+		StaleAfterSeconds: int64(staleAfter / time.Second),
+	}
+
+	for _, bucket := range coverageReportOptions.Buckets {
+		coverage := resourceConfiguration.bucketCoverage(ctx, bucket, now, staleAfter, coverageReportOptions.Headers)
+		if ctx.Err() != nil {
+			result = nil
+			err = core.SDKErrorf(ctx.Err(), "", "coverage-canceled", common.GetComponentInfo())
+			return
+		}
+		result.Buckets = append(result.Buckets, coverage)
+	}
+	return
+}
+
+func (resourceConfiguration *ResourceConfigurationV1) bucketCoverage(ctx context.Context, bucket string, now time.Time, staleAfter time.Duration, headers map[string]string) (coverage BucketCoverage) {
+	coverage = BucketCoverage{
+		Bucket:   bucket,
+		Policies: []PolicyCoverage{},
+	}
+
+	getBucketConfigOptions := resourceConfiguration.NewGetBucketConfigOptions(bucket)
+	getBucketConfigOptions.SetHeaders(headers)
+	bucketConfig, _, err := resourceConfiguration.GetBucketConfigWithContext(ctx, getBucketConfigOptions)
+	if err != nil {
+		coverage.Flags = []string{CoverageFlag_Error}
+		coverage.Error = err.Error()
+		return
+	}
+	coverage.BucketCrn = core.StringNilMapper(bucketConfig.Crn)
+
+	listBackupPoliciesOptions := resourceConfiguration.NewListBackupPoliciesOptions(bucket)
+	listBackupPoliciesOptions.SetHeaders(headers)
+	policies, _, err := resourceConfiguration.ListBackupPoliciesWithContext(ctx, listBackupPoliciesOptions)
+	if err != nil {
+		coverage.Flags = []string{CoverageFlag_Error}
+		coverage.Error = err.Error()
+		return
+	}
+	if len(policies.BackupPolicies) == 0 {
+		coverage.Flags = []string{CoverageFlag_Unprotected}
+		return
+	}
+
+	for i := range policies.BackupPolicies {
+		coverage.Policies = append(coverage.Policies,
+			resourceConfiguration.policyCoverage(ctx, coverage.BucketCrn, &policies.BackupPolicies[i], now, staleAfter, headers))
+	}
+	return
+}
+
+func (resourceConfiguration *ResourceConfigurationV1) policyCoverage(ctx context.Context, bucketCrn string, policy *BackupPolicy, now time.Time, staleAfter time.Duration, headers map[string]string) (coverage PolicyCoverage) {
+	coverage = PolicyCoverage{
+		Policy: policy,
+	}
+	switch core.StringNilMapper(policy.PolicyStatus) {
+	case BackupPolicy_PolicyStatus_ActionNeeded, BackupPolicy_PolicyStatus_Degraded, BackupPolicy_PolicyStatus_Failed:
+		coverage.Flags = append(coverage.Flags, CoverageFlag_Unhealthy)
+	}
+
+	vault, err := crn.ParseBackupVault(core.StringNilMapper(policy.TargetBackupVaultCrn))
+	if err != nil {
+		coverage.Flags = append(coverage.Flags, CoverageFlag_Error)
+		coverage.Error = err.Error()
+		return
+	}
+	coverage.BackupVaultName = vault.Resource
+
+	// Without the CRN of the bucket, its recovery ranges cannot be told apart from those of other buckets in the vault.
+	if bucketCrn == "" {
+		coverage.Flags = append(coverage.Flags, CoverageFlag_Error)
+		coverage.Error = "the bucket configuration has no CRN, so the recovery ranges of the bucket cannot be identified"
+		return
+	}
+
+	listRecoveryRangesOptions := resourceConfiguration.NewListRecoveryRangesOptions(vault.Resource)
+	listRecoveryRangesOptions.SetLatest("true")
+	listRecoveryRangesOptions.SetSourceResourceCrn(bucketCrn)
+	listRecoveryRangesOptions.SetHeaders(headers)
+	pager, err := resourceConfiguration.NewRecoveryRangesPager(listRecoveryRangesOptions)
+	if err == nil {
+		var ranges []RecoveryRange
+		ranges, err = pager.GetAllWithContext(ctx)
+		coverage.LatestRecoveryRange = latestRecoveryRange(ranges, bucketCrn)
+	}
+	if err != nil {
+		coverage.Flags = append(coverage.Flags, CoverageFlag_Error)
+		coverage.Error = err.Error()
+		return
+	}
+
+	if coverage.LatestRecoveryRange == nil || coverage.LatestRecoveryRange.RangeEndTime == nil {
+		coverage.Flags = append(coverage.Flags, CoverageFlag_Stale)
+		return
+	}
+	gap := now.Sub(time.Time(*coverage.LatestRecoveryRange.RangeEndTime))
+	if gap < 0 {
+		gap = 0
+	}
+	coverage.RecoveryPointGapSeconds = core.Int64Ptr(int64(gap / time.Second))
+	if gap > staleAfter {
+		coverage.Flags = append(coverage.Flags, CoverageFlag_Stale)
+	}
+	return
+}
+
+// latestRecoveryRange returns the range of "bucketCrn" that ends last.
+func latestRecoveryRange(ranges []RecoveryRange, bucketCrn string) (latest *RecoveryRange) {
+	for i := range ranges {
+		recoveryRange := &ranges[i]
+		if core.StringNilMapper(recoveryRange.SourceResourceCrn) != bucketCrn {
+			continue
+		}
+		if latest == nil || endsAfter(recoveryRange, latest) {
+			latest = recoveryRange
+		}
+	}
+	return
+}
+
+func endsAfter(a *RecoveryRange, b *RecoveryRange) bool {
+	if b.RangeEndTime == nil {
+		return a.RangeEndTime != nil
+	}
+	return a.RangeEndTime != nil && time.Time(*a.RangeEndTime).After(time.Time(*b.RangeEndTime))
+}
@@ -0,0 +1,186 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourceconfigurationv1_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/ibm-cos-sdk-go-config/v2/resourceconfigurationv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ResourceConfigurationV1 coverage report`, func() {
+	const crnPrefix = "crn:v1:bluemix:public:cloud-object-storage:global:a/3bf0d9003abfb5d29761c3e97696b71c:d6f04d83-6c4f-4a62-a165-696756d63903:"
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	var testServer *httptest.Server
+	var resourceConfigurationService *resourceconfigurationv1.ResourceConfigurationV1
+	BeforeEach(func() {
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.Method).To(Equal("GET"))
+			res.Header().Set("Content-type", "application/json")
+			switch path := req.URL.EscapedPath(); {
+			case path == "/b/missing":
+				res.WriteHeader(403)
+				fmt.Fprint(res, `{"errors": [{"code": "forbidden", "message": "access denied"}]}`)
+			case strings.HasSuffix(path, "/backup_policies"):
+				switch path {
+				case "/buckets/protected/backup_policies":
+					fmt.Fprintf(res, `{"backup_policies": [
+						{"policy_id": "p1", "policy_name": "fresh", "target_backup_vault_crn": "%[1]sbackup-vault:vault-a", "backup_type": "continuous", "initial_retention": {"delete_after_days": 1}, "policy_status": "active"},
+						{"policy_id": "p2", "policy_name": "old", "target_backup_vault_crn": "%[1]sbackup-vault:vault-b", "backup_type": "continuous", "initial_retention": {"delete_after_days": 1}, "policy_status": "degraded"},
+						{"policy_id": "p3", "policy_name": "new", "target_backup_vault_crn": "%[1]sbackup-vault:vault-c", "backup_type": "continuous", "initial_retention": {"delete_after_days": 1}, "policy_status": "initializing", "initial_sync_progress": 40}
+					]}`, crnPrefix)
+				case "/buckets/anonymous/backup_policies":
+					fmt.Fprintf(res, `{"backup_policies": [
+						{"policy_id": "p4", "policy_name": "fresh", "target_backup_vault_crn": "%sbackup-vault:vault-a", "backup_type": "continuous", "initial_retention": {"delete_after_days": 1}, "policy_status": "active"}
+					]}`, crnPrefix)
+				default:
+					fmt.Fprint(res, `{"backup_policies": []}`)
+				}
+			case path == "/b/anonymous":
+				fmt.Fprint(res, `{"name": "anonymous"}`)
+			case strings.HasPrefix(path, "/b/"):
+				name := strings.TrimPrefix(path, "/b/")
+				fmt.Fprintf(res, `{"name": "%s", "crn": "%sbucket:%s"}`, name, crnPrefix, name)
+			case strings.HasSuffix(path, "/recovery_ranges"):
+				Expect(req.URL.Query().Get("latest")).To(Equal("true"))
+				Expect(req.URL.Query().Get("source_resource_crn")).To(Equal(crnPrefix + "bucket:protected"))
+				ranges := map[string]string{
+					"/backup_vaults/vault-a/recovery_ranges": fmt.Sprintf(`[
+						{"recovery_range_id": "r1", "source_resource_crn": "%[1]sbucket:protected", "range_end_time": "2025-06-01T10:00:00.000Z"},
+						{"recovery_range_id": "r2", "source_resource_crn": "%[1]sbucket:protected", "range_end_time": "2025-06-01T11:30:00.000Z"}
+					]`, crnPrefix),
+					"/backup_vaults/vault-b/recovery_ranges": fmt.Sprintf(`[
+						{"recovery_range_id": "r3", "source_resource_crn": "%sbucket:protected", "range_end_time": "2025-05-30T12:00:00.000Z"}
+					]`, crnPrefix),
+					"/backup_vaults/vault-c/recovery_ranges": `[]`,
+				}
+				fmt.Fprintf(res, `{"recovery_ranges": %s}`, ranges[path])
+			default:
+				Fail("unexpected request " + path)
+			}
+		}))
+		var serviceErr error
+		resourceConfigurationService, serviceErr = resourceconfigurationv1.NewResourceConfigurationV1(&resourceconfigurationv1.ResourceConfigurationV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+	It(`Invoke GetCoverageReport successfully`, func() {
+		coverageReportOptionsModel := resourceConfigurationService.NewCoverageReportOptions([]string{"protected", "unprotected", "missing"})
+		coverageReportOptionsModel.SetNow(now)
+		coverageReportOptionsModel.SetStaleAfter(time.Hour)
+		report, operationErr := resourceConfigurationService.GetCoverageReport(coverageReportOptionsModel)
+		Expect(operationErr).To(BeNil())
+		Expect(report.StaleAfterSeconds).To(Equal(int64(3600)))
+		Expect(report.Buckets).To(HaveLen(3))
+
+		protected := report.Buckets[0]
+		Expect(protected.BucketCrn).To(Equal(crnPrefix + "bucket:protected"))
+		Expect(protected.Flags).To(BeEmpty())
+		Expect(protected.Policies).To(HaveLen(3))
+
+		fresh := protected.Policies[0]
+		Expect(fresh.BackupVaultName).To(Equal("vault-a"))
+		Expect(*fresh.LatestRecoveryRange.RecoveryRangeID).To(Equal("r2"))
+		gap, ok := fresh.RecoveryPointGap()
+		Expect(ok).To(BeTrue())
+		Expect(gap).To(Equal(30 * time.Minute))
+		Expect(fresh.Flags).To(BeEmpty())
+
+		old := protected.Policies[1]
+		Expect(*old.RecoveryPointGapSeconds).To(Equal(int64(48 * 3600)))
+		Expect(old.Flags).To(Equal([]string{resourceconfigurationv1.CoverageFlag_Unhealthy, resourceconfigurationv1.CoverageFlag_Stale}))
+
+		initializing := protected.Policies[2]
+		Expect(initializing.LatestRecoveryRange).To(BeNil())
+		_, ok = initializing.RecoveryPointGap()
+		Expect(ok).To(BeFalse())
+		Expect(initializing.Flags).To(Equal([]string{resourceconfigurationv1.CoverageFlag_Stale}))
+
+		Expect(report.Buckets[1].Flags).To(Equal([]string{resourceconfigurationv1.CoverageFlag_Unprotected}))
+		Expect(report.Buckets[1].Policies).To(BeEmpty())
+		Expect(report.Buckets[2].Flags).To(Equal([]string{resourceconfigurationv1.CoverageFlag_Error}))
+		Expect(report.Buckets[2].Error).To(ContainSubstring("access denied"))
+		Expect(report.Flagged()).To(HaveLen(3))
+
+		var table bytes.Buffer
+		Expect(report.WriteTable(&table)).To(Succeed())
+		lines := strings.Split(strings.TrimSpace(table.String()), "\n")
+		Expect(lines).To(HaveLen(6))
+		Expect(lines[0]).To(MatchRegexp(`^BUCKET\s+POLICY\s+STATUS\s+INITIAL SYNC\s+VAULT\s+LATEST RANGE END\s+RECOVERY POINT GAP\s+FLAGS$`))
+		Expect(lines[1]).To(MatchRegexp(`^protected\s+fresh\s+active\s+-\s+vault-a\s+2025-06-01T11:30:00Z\s+30m0s\s*$`))
+		Expect(lines[2]).To(MatchRegexp(`old\s+degraded\s+-\s+vault-b\s+2025-05-30T12:00:00Z\s+48h0m0s\s+unhealthy,stale$`))
+		Expect(lines[3]).To(MatchRegexp(`new\s+initializing\s+40%\s+vault-c\s+-\s+-\s+stale$`))
+		Expect(lines[4]).To(MatchRegexp(`^unprotected\s+-.*unprotected$`))
+		Expect(lines[5]).To(MatchRegexp(`^missing\s+-.*error$`))
+
+		var buffer bytes.Buffer
+		Expect(report.WriteJSON(&buffer)).To(Succeed())
+		var generic map[string]interface{}
+		Expect(json.Unmarshal(buffer.Bytes(), &generic)).To(Succeed())
+		Expect(generic["generated_at"]).To(Equal("2025-06-01T12:00:00.000Z"))
+		policies := generic["buckets"].([]interface{})[0].(map[string]interface{})["policies"].([]interface{})
+		Expect(policies[0].(map[string]interface{})["recovery_point_gap_seconds"]).To(Equal(float64(1800)))
+	})
+	It(`Invoke GetCoverageReport for a bucket without a CRN`, func() {
+		coverageReportOptionsModel := resourceConfigurationService.NewCoverageReportOptions([]string{"anonymous"})
+		coverageReportOptionsModel.SetNow(now)
+		report, operationErr := resourceConfigurationService.GetCoverageReport(coverageReportOptionsModel)
+		Expect(operationErr).To(BeNil())
+		anonymous := report.Buckets[0]
+		Expect(anonymous.BucketCrn).To(BeEmpty())
+		Expect(anonymous.Policies).To(HaveLen(1))
+		policy := anonymous.Policies[0]
+		Expect(policy.BackupVaultName).To(Equal("vault-a"))
+		Expect(policy.Flags).To(Equal([]string{resourceconfigurationv1.CoverageFlag_Error}))
+		Expect(policy.Error).To(ContainSubstring("no CRN"))
+		Expect(policy.LatestRecoveryRange).To(BeNil())
+		Expect(policy.RecoveryPointGapSeconds).To(BeNil())
+		Expect(report.Flagged()).To(HaveLen(1))
+	})
+	It(`Invoke GetCoverageReport with error`, func() {
+		report, operationErr := resourceConfigurationService.GetCoverageReport(nil)
+		Expect(operationErr).ToNot(BeNil())
+		Expect(report).To(BeNil())
+
+		report, operationErr = resourceConfigurationService.GetCoverageReport(resourceConfigurationService.NewCoverageReportOptions(nil))
+		Expect(operationErr).ToNot(BeNil())
+		Expect(report).To(BeNil())
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		report, operationErr = resourceConfigurationService.GetCoverageReportWithContext(ctx, resourceConfigurationService.NewCoverageReportOptions([]string{"protected"}))
+		Expect(operationErr).ToNot(BeNil())
+		Expect(report).To(BeNil())
+	})
+})
@@ -0,0 +1,106 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourceconfigurationv1
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/ibm-cos-sdk-go-config/v2/common"
+)
+
+// Constants associated with the network used to reach the service.
+// - public: the public internet.
+// - private: the IBM Cloud private network, e.g. from IBM Cloud VPC.
+// - direct: IBM Cloud Direct Link and VPC virtual private endpoints.
+const (
+	EndpointTypeDirect  = "direct"
+	EndpointTypePrivate = "private"
+	EndpointTypePublic  = "public"
+)
+
+// RegionGlobal selects the global endpoint, which serves every region.
+const RegionGlobal = "global"
+
+// regions lists the regions with a regional Resource Configuration endpoint.
+var regions = []string{
+	"au-syd",
+	"br-sao",
+	"ca-tor",
+	"eu-de",
+	"eu-es",
+	"eu-gb",
+	"jp-osa",
+	"jp-tok",
+	"us-east",
+	"us-south",
+}
+
+// GetServiceURLForEndpoint returns the service URL for the specified region and endpoint type.
+//
+// An empty region or "global" selects the global endpoint, and an empty endpoint type selects the public network.
+// For example, region "us-south" with endpoint type "private" resolves to
+// "https://config.private.us-south.cloud-object-storage.cloud.ibm.com/v1".
+func GetServiceURLForEndpoint(region string, endpointType string) (string, error) {
+	var host string
+	switch endpointType {
+	case "", EndpointTypePublic:
+		host = "config."
+	case EndpointTypePrivate, EndpointTypeDirect:
+		host = "config." + endpointType + "."
+	default:
+		err := core.SDKErrorf(nil, fmt.Sprintf("endpoint type %q is not one of %s, %s or %s", endpointType, EndpointTypePublic, EndpointTypePrivate, EndpointTypeDirect), "invalid-endpoint-type", common.GetComponentInfo())
+		return "", err
+	}
+
+	if region != "" && region != RegionGlobal {
+		if !isRegion(region) {
+			err := core.SDKErrorf(nil, fmt.Sprintf("region %q is not supported (supported regions: %s, %s)", region, RegionGlobal, strings.Join(regions, ", ")), "invalid-region", common.GetComponentInfo())
+			return "", err
+		}
+		host += region + "."
+	}
+	return "https://" + host + "cloud-object-storage.cloud.ibm.com/v1", nil
+}
+
+func isRegion(region string) bool {
+	for _, r := range regions {
+		if r == region {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveServiceURL returns the service URL selected by "options": the URL itself, or the endpoint for the
+// Region and EndpointType. It returns "" when none of them are set.
+func (options *ResourceConfigurationV1Options) resolveServiceURL() (string, error) {
+	if options.Region == "" && options.EndpointType == "" {
+		return options.URL, nil
+	}
+	if options.URL != "" {
+		err := core.SDKErrorf(nil, "URL cannot be combined with Region or EndpointType", "url-endpoint-conflict", common.GetComponentInfo())
+		return "", err
+	}
+	url, err := GetServiceURLForEndpoint(options.Region, options.EndpointType)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "endpoint-resolve-error")
+		return "", err
+	}
+	return url, nil
+}
@@ -0,0 +1,106 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourceconfigurationv1_test
+
+import (
+	"os"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/ibm-cos-sdk-go-config/v2/resourceconfigurationv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ResourceConfigurationV1 endpoints`, func() {
+	Describe(`GetServiceURLForEndpoint(region string, endpointType string)`, func() {
+		It(`Resolve global and regional endpoints`, func() {
+			for _, endpoint := range []struct {
+				region       string
+				endpointType string
+				url          string
+			}{
+				{"", "", resourceconfigurationv1.DefaultServiceURL},
+				{"global", "public", resourceconfigurationv1.DefaultServiceURL},
+				{"", "private", "https://config.private.cloud-object-storage.cloud.ibm.com/v1"},
+				{"global", "direct", "https://config.direct.cloud-object-storage.cloud.ibm.com/v1"},
+				{"us-south", "", "https://config.us-south.cloud-object-storage.cloud.ibm.com/v1"},
+				{"eu-de", "private", "https://config.private.eu-de.cloud-object-storage.cloud.ibm.com/v1"},
+				{"jp-tok", "direct", "https://config.direct.jp-tok.cloud-object-storage.cloud.ibm.com/v1"},
+			} {
+				url, err := resourceconfigurationv1.GetServiceURLForEndpoint(endpoint.region, endpoint.endpointType)
+				Expect(err).To(BeNil())
+				Expect(url).To(Equal(endpoint.url))
+			}
+
+			url, err := resourceconfigurationv1.GetServiceURLForRegion("ca-tor")
+			Expect(err).To(BeNil())
+			Expect(url).To(Equal("https://config.ca-tor.cloud-object-storage.cloud.ibm.com/v1"))
+		})
+		It(`Invoke GetServiceURLForEndpoint with error: invalid combinations`, func() {
+			url, err := resourceconfigurationv1.GetServiceURLForEndpoint("us-north", "private")
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring(`region "us-north" is not supported`))
+			Expect(url).To(BeEmpty())
+
+			url, err = resourceconfigurationv1.GetServiceURLForEndpoint("us-south", "vpc")
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring(`endpoint type "vpc" is not one of public, private or direct`))
+			Expect(url).To(BeEmpty())
+		})
+	})
+	Describe(`Service constructor options`, func() {
+		It(`Set the endpoint from Region and EndpointType`, func() {
+			resourceConfigurationService, err := resourceconfigurationv1.NewResourceConfigurationV1(&resourceconfigurationv1.ResourceConfigurationV1Options{
+				Region:        "us-east",
+				EndpointType:  resourceconfigurationv1.EndpointTypeDirect,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(err).To(BeNil())
+			Expect(resourceConfigurationService.GetServiceURL()).To(Equal("https://config.direct.us-east.cloud-object-storage.cloud.ibm.com/v1"))
+		})
+		It(`Region and EndpointType override the external configuration`, func() {
+			os.Setenv("RESOURCE_CONFIGURATION_URL", "https://config.cloud-object-storage.cloud.ibm.com/v1")
+			os.Setenv("RESOURCE_CONFIGURATION_AUTH_TYPE", "noauth")
+			defer os.Unsetenv("RESOURCE_CONFIGURATION_URL")
+			defer os.Unsetenv("RESOURCE_CONFIGURATION_AUTH_TYPE")
+
+			resourceConfigurationService, err := resourceconfigurationv1.NewResourceConfigurationV1UsingExternalConfig(&resourceconfigurationv1.ResourceConfigurationV1Options{
+				EndpointType: resourceconfigurationv1.EndpointTypePrivate,
+			})
+			Expect(err).To(BeNil())
+			Expect(resourceConfigurationService.GetServiceURL()).To(Equal("https://config.private.cloud-object-storage.cloud.ibm.com/v1"))
+		})
+		It(`Invoke constructor with error: URL combined with EndpointType`, func() {
+			resourceConfigurationService, err := resourceconfigurationv1.NewResourceConfigurationV1(&resourceconfigurationv1.ResourceConfigurationV1Options{
+				URL:           "https://config.cloud-object-storage.cloud.ibm.com/v1",
+				EndpointType:  resourceconfigurationv1.EndpointTypePrivate,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("URL cannot be combined with Region or EndpointType"))
+			Expect(resourceConfigurationService).To(BeNil())
+		})
+		It(`Invoke constructor with error: unsupported region`, func() {
+			resourceConfigurationService, err := resourceconfigurationv1.NewResourceConfigurationV1(&resourceconfigurationv1.ResourceConfigurationV1Options{
+				Region:        "INVALID_REGION",
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(err).ToNot(BeNil())
+			Expect(resourceConfigurationService).To(BeNil())
+		})
+	})
+})
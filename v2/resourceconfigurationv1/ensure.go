@@ -0,0 +1,247 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourceconfigurationv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/ibm-cos-sdk-go-config/v2/common"
+)
+
+// BackupVaultSpec : The backup vault EnsureBucketBackedUp creates when it does not exist.
+type BackupVaultSpec struct {
+	// Name of the service_instance to create the BackupVault in.
+	ServiceInstanceID *string `json:"service_instance_id" validate:"required,ne="`
+
+	// The name given to the BackupVault.
+	BackupVaultName *string `json:"backup_vault_name" validate:"required,ne="`
+
+	// the region in which the backup-vault should be created within.
+	Region *string `json:"region" validate:"required,ne="`
+
+	// Activity Tracking configuration.
+	ActivityTracking *BackupVaultActivityTracking `json:"activity_tracking,omitempty"`
+
+	// Metrics Monitoring configuration.
+	MetricsMonitoring *BackupVaultMetricsMonitoring `json:"metrics_monitoring,omitempty"`
+
+	// The CRN for a KeyProtect root key.
+	SseKpCustomerRootKeyCrn *string `json:"sse_kp_customer_root_key_crn,omitempty"`
+}
+
+// NewBackupVaultSpec : Instantiate BackupVaultSpec
+func (*ResourceConfigurationV1) NewBackupVaultSpec(serviceInstanceID string, backupVaultName string, region string) *BackupVaultSpec {
+	return &BackupVaultSpec{
+		ServiceInstanceID: core.StringPtr(serviceInstanceID),
+		BackupVaultName:   core.StringPtr(backupVaultName),
+		Region:            core.StringPtr(region),
+	}
+}
+
+// SetActivityTracking : Allow user to set ActivityTracking
+func (_options *BackupVaultSpec) SetActivityTracking(activityTracking *BackupVaultActivityTracking) *BackupVaultSpec {
+	_options.ActivityTracking = activityTracking
+	return _options
+}
+
+// SetMetricsMonitoring : Allow user to set MetricsMonitoring
+func (_options *BackupVaultSpec) SetMetricsMonitoring(metricsMonitoring *BackupVaultMetricsMonitoring) *BackupVaultSpec {
+	_options.MetricsMonitoring = metricsMonitoring
+	return _options
+}
+
+// SetSseKpCustomerRootKeyCrn : Allow user to set SseKpCustomerRootKeyCrn
+func (_options *BackupVaultSpec) SetSseKpCustomerRootKeyCrn(sseKpCustomerRootKeyCrn string) *BackupVaultSpec {
+	_options.SseKpCustomerRootKeyCrn = core.StringPtr(sseKpCustomerRootKeyCrn)
+	return _options
+}
+
+// BackupPolicySpec : The backup policy EnsureBucketBackedUp creates when the bucket has no policy of the same name.
+type BackupPolicySpec struct {
+	// The name granted to the policy.
+	PolicyName *string `json:"policy_name" validate:"required,ne="`
+
+	// The number of days to retain data within a RecoveryRange.
+	InitialRetention *DeleteAfterDays `json:"initial_retention" validate:"required"`
+
+	// The type of backup to support. Defaults to "continuous".
+	BackupType *string `json:"backup_type,omitempty"`
+
+	// The time to wait between checks of the policy status. Defaults to DefaultWaitPollInterval.
+	PollInterval time.Duration
+}
+
+// NewBackupPolicySpec : Instantiate BackupPolicySpec
+func (*ResourceConfigurationV1) NewBackupPolicySpec(policyName string, initialRetention *DeleteAfterDays) *BackupPolicySpec {
+	return &BackupPolicySpec{
+		PolicyName:       core.StringPtr(policyName),
+		InitialRetention: initialRetention,
+	}
+}
+
+// SetBackupType : Allow user to set BackupType
+func (_options *BackupPolicySpec) SetBackupType(backupType string) *BackupPolicySpec {
+	_options.BackupType = core.StringPtr(backupType)
+	return _options
+}
+
+// SetPollInterval : Allow user to set PollInterval
+func (_options *BackupPolicySpec) SetPollInterval(pollInterval time.Duration) *BackupPolicySpec {
+	_options.PollInterval = pollInterval
+	return _options
+}
+
+// EnsureBucketBackedUp : Back up a bucket to a backup vault
+// Creates the backup vault described by "vaultSpec" unless a vault of that name exists, creates the backup policy
+// described by "policySpec" unless the bucket has a policy of that name, and waits until the policy is `active`.
+// Existing vaults and policies are used as they are; an existing policy of the same name must target the vault.
+//
+// If a step fails, the policy and vault created by this call are deleted again before the error is returned. Resources
+// that existed before the call are never deleted. A vault cannot be deleted once it holds RecoveryRanges, which the
+// created policy may already have produced; such a vault, and any resource whose deletion fails, is left behind and the
+// failure to delete it is reported in the returned error along with the failure of the step.
+func (resourceConfiguration *ResourceConfigurationV1) EnsureBucketBackedUp(ctx context.Context, bucket string, vaultSpec *BackupVaultSpec, policySpec *BackupPolicySpec) (policy *BackupPolicy, vault *BackupVault, err error) {
+	err = core.ValidateNotNil(vaultSpec, "vaultSpec cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(vaultSpec, "vaultSpec")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateNotNil(policySpec, "policySpec cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(policySpec, "policySpec")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	if bucket == "" {
+		err = core.SDKErrorf(nil, "bucket cannot be empty", "missing-bucket", common.GetComponentInfo())
+		return
+	}
+
+	vault, createdVault, err := resourceConfiguration.ensureBackupVault(ctx, vaultSpec)
+	if err != nil {
+		return nil, nil, err
+	}
+	policy, createdPolicy, err := resourceConfiguration.ensureBackupPolicy(ctx, bucket, vault, policySpec)
+	if err == nil {
+		waitForBackupPolicyOptions := resourceConfiguration.NewWaitForBackupPolicyOptions(bucket, core.StringNilMapper(policy.PolicyID))
+		waitForBackupPolicyOptions.SetPollInterval(policySpec.PollInterval)
+		policy, err = resourceConfiguration.WaitForBackupPolicyWithContext(ctx, waitForBackupPolicyOptions)
+		err = core.RepurposeSDKProblem(err, "ensure-wait-backup-policy-error")
+	}
+	if err != nil {
+		// Clean up even when the failure is a canceled context.
+		cleanupCtx := context.WithoutCancel(ctx)
+		cleanupErrs := []error{}
+		if createdPolicy != nil && createdPolicy.PolicyID != nil {
+			deleteBackupPolicyOptions := resourceConfiguration.NewDeleteBackupPolicyOptions(bucket, *createdPolicy.PolicyID)
+			if _, deleteErr := resourceConfiguration.DeleteBackupPolicyWithContext(cleanupCtx, deleteBackupPolicyOptions); deleteErr != nil {
+				cleanupErrs = append(cleanupErrs, core.SDKErrorf(deleteErr, fmt.Sprintf("unable to delete backup policy %s of bucket %s created by this call: %s",
+					*createdPolicy.PolicyID, bucket, deleteErr.Error()), "ensure-cleanup-backup-policy-error", common.GetComponentInfo()))
+			}
+		}
+		if createdVault {
+			deleteBackupVaultOptions := resourceConfiguration.NewDeleteBackupVaultOptions(*vaultSpec.BackupVaultName)
+			if _, deleteErr := resourceConfiguration.DeleteBackupVaultWithContext(cleanupCtx, deleteBackupVaultOptions); deleteErr != nil {
+				cleanupErrs = append(cleanupErrs, core.SDKErrorf(deleteErr, fmt.Sprintf("unable to delete backup vault %s created by this call: %s",
+					*vaultSpec.BackupVaultName, deleteErr.Error()), "ensure-cleanup-backup-vault-error", common.GetComponentInfo()))
+			}
+		}
+		if len(cleanupErrs) > 0 {
+			err = core.SDKErrorf(errors.Join(append([]error{err}, cleanupErrs...)...), "", "ensure-cleanup-error", common.GetComponentInfo())
+		}
+		return nil, nil, err
+	}
+	return policy, vault, nil
+}
+
+// ensureBackupVault returns the vault named in "vaultSpec", creating it if it does not exist.
+func (resourceConfiguration *ResourceConfigurationV1) ensureBackupVault(ctx context.Context, vaultSpec *BackupVaultSpec) (vault *BackupVault, created bool, err error) {
+	getBackupVaultOptions := resourceConfiguration.NewGetBackupVaultOptions(*vaultSpec.BackupVaultName)
+	vault, response, err := resourceConfiguration.GetBackupVaultWithContext(ctx, getBackupVaultOptions)
+	if err == nil {
+		return vault, false, nil
+	}
+	if response == nil || response.StatusCode != http.StatusNotFound {
+		err = core.RepurposeSDKProblem(err, "ensure-get-backup-vault-error")
+		return nil, false, err
+	}
+
+	createBackupVaultOptions := resourceConfiguration.NewCreateBackupVaultOptions(*vaultSpec.ServiceInstanceID, *vaultSpec.BackupVaultName, *vaultSpec.Region)
+	createBackupVaultOptions.ActivityTracking = vaultSpec.ActivityTracking
+	createBackupVaultOptions.MetricsMonitoring = vaultSpec.MetricsMonitoring
+	createBackupVaultOptions.SseKpCustomerRootKeyCrn = vaultSpec.SseKpCustomerRootKeyCrn
+	vault, _, err = resourceConfiguration.CreateBackupVaultWithContext(ctx, createBackupVaultOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "ensure-create-backup-vault-error")
+		return nil, false, err
+	}
+	return vault, true, nil
+}
+
+// ensureBackupPolicy returns the policy of "bucket" named in "policySpec", creating it if it does not exist. "created"
+// is the policy if this call created it.
+func (resourceConfiguration *ResourceConfigurationV1) ensureBackupPolicy(ctx context.Context, bucket string, vault *BackupVault, policySpec *BackupPolicySpec) (policy *BackupPolicy, created *BackupPolicy, err error) {
+	if vault.Crn == nil {
+		err = core.SDKErrorf(nil, fmt.Sprintf("backup vault %s has no CRN", core.StringNilMapper(vault.BackupVaultName)), "missing-backup-vault-crn", common.GetComponentInfo())
+		return
+	}
+
+	listBackupPoliciesOptions := resourceConfiguration.NewListBackupPoliciesOptions(bucket)
+	policies, _, err := resourceConfiguration.ListBackupPoliciesWithContext(ctx, listBackupPoliciesOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "ensure-list-backup-policies-error")
+		return
+	}
+	for i := range policies.BackupPolicies {
+		existing := &policies.BackupPolicies[i]
+		if core.StringNilMapper(existing.PolicyName) != *policySpec.PolicyName {
+			continue
+		}
+		if !sameBackupVault(core.StringNilMapper(existing.TargetBackupVaultCrn), *vault.Crn) {
+			err = core.SDKErrorf(nil, fmt.Sprintf("backup policy %s of bucket %s targets %s, not %s", *policySpec.PolicyName, bucket,
+				core.StringNilMapper(existing.TargetBackupVaultCrn), *vault.Crn), "backup-policy-conflict", common.GetComponentInfo())
+			return
+		}
+		return existing, nil, nil
+	}
+
+	backupType := CreateBackupPolicyOptions_BackupType_Continuous
+	if policySpec.BackupType != nil {
+		backupType = *policySpec.BackupType
+	}
+	createBackupPolicyOptions := resourceConfiguration.NewCreateBackupPolicyOptions(bucket, policySpec.InitialRetention, *policySpec.PolicyName, *vault.Crn, backupType)
+	policy, _, err = resourceConfiguration.CreateBackupPolicyWithContext(ctx, createBackupPolicyOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "ensure-create-backup-policy-error")
+		return
+	}
+	return policy, policy, nil
+}
@@ -0,0 +1,243 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourceconfigurationv1_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/ibm-cos-sdk-go-config/v2/resourceconfigurationv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ResourceConfigurationV1 EnsureBucketBackedUp`, func() {
+	const vaultCRN = "crn:v1:bluemix:public:cloud-object-storage:global:a/3bf0d9003abfb5d29761c3e97696b71c:d6f04d83-6c4f-4a62-a165-696756d63903:backup-vault:my-vault"
+	var testServer *httptest.Server
+	var mutex sync.Mutex
+	var vaultExists bool
+	var vaultHasRanges bool
+	var policies []map[string]interface{}
+	var policyStatuses []string
+	var createPolicyStatus int
+	var requests []string
+	var vaultBody map[string]interface{}
+	var resourceConfigurationService *resourceconfigurationv1.ResourceConfigurationV1
+	BeforeEach(func() {
+		vaultExists = false
+		vaultHasRanges = false
+		policies = nil
+		policyStatuses = []string{"pending", "initializing", "active"}
+		createPolicyStatus = 201
+		requests = nil
+		vaultBody = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			request := req.Method + " " + req.URL.EscapedPath()
+			requests = append(requests, request)
+			res.Header().Set("Content-type", "application/json")
+			vault := fmt.Sprintf(`{"backup_vault_name": "my-vault", "region": "us-south", "crn": "%s"}`, vaultCRN)
+			switch request {
+			case "GET /backup_vaults/my-vault":
+				if !vaultExists {
+					res.WriteHeader(404)
+					fmt.Fprint(res, `{"errors": [{"code": "not_found", "message": "not found"}]}`)
+					return
+				}
+				fmt.Fprint(res, vault)
+			case "POST /backup_vaults":
+				Expect(json.NewDecoder(req.Body).Decode(&vaultBody)).To(Succeed())
+				vaultExists = true
+				res.WriteHeader(201)
+				fmt.Fprint(res, vault)
+			case "DELETE /backup_vaults/my-vault":
+				if vaultHasRanges {
+					res.WriteHeader(409)
+					fmt.Fprint(res, `{"errors": [{"code": "conflict", "message": "backup vault contains recovery ranges"}]}`)
+					return
+				}
+				vaultExists = false
+				res.WriteHeader(204)
+			case "GET /buckets/my-bucket/backup_policies":
+				body, _ := json.Marshal(map[string]interface{}{"backup_policies": policies})
+				res.Write(body)
+			case "POST /buckets/my-bucket/backup_policies":
+				if createPolicyStatus != 201 {
+					res.WriteHeader(createPolicyStatus)
+					fmt.Fprint(res, `{"errors": [{"code": "bad_request", "message": "bucket versioning is not enabled"}]}`)
+					return
+				}
+				var policy map[string]interface{}
+				Expect(json.NewDecoder(req.Body).Decode(&policy)).To(Succeed())
+				Expect(policy["target_backup_vault_crn"]).To(Equal(vaultCRN))
+				Expect(policy["backup_type"]).To(Equal("continuous"))
+				policy["policy_id"] = "new-policy"
+				policy["policy_status"] = "pending"
+				policies = append(policies, policy)
+				res.WriteHeader(201)
+				body, _ := json.Marshal(policy)
+				res.Write(body)
+			case "GET /buckets/my-bucket/backup_policies/new-policy", "GET /buckets/my-bucket/backup_policies/old-policy":
+				policy := policies[0]
+				policy["policy_status"] = policyStatuses[0]
+				if len(policyStatuses) > 1 {
+					policyStatuses = policyStatuses[1:]
+				}
+				body, _ := json.Marshal(policy)
+				res.Write(body)
+			case "DELETE /buckets/my-bucket/backup_policies/new-policy":
+				policies = nil
+				res.WriteHeader(204)
+			default:
+				Fail("unexpected request " + request)
+			}
+		}))
+		var serviceErr error
+		resourceConfigurationService, serviceErr = resourceconfigurationv1.NewResourceConfigurationV1(&resourceconfigurationv1.ResourceConfigurationV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+	newSpecs := func() (*resourceconfigurationv1.BackupVaultSpec, *resourceconfigurationv1.BackupPolicySpec) {
+		vaultSpec := resourceConfigurationService.NewBackupVaultSpec("testInstance", "my-vault", "us-south")
+		vaultSpec.SetActivityTracking(&resourceconfigurationv1.BackupVaultActivityTracking{ManagementEvents: core.BoolPtr(true)})
+		vaultSpec.SetMetricsMonitoring(&resourceconfigurationv1.BackupVaultMetricsMonitoring{UsageMetricsEnabled: core.BoolPtr(true)})
+		vaultSpec.SetSseKpCustomerRootKeyCrn("testKey")
+		policySpec := resourceConfigurationService.NewBackupPolicySpec("daily", &resourceconfigurationv1.DeleteAfterDays{DeleteAfterDays: core.Int64Ptr(30)})
+		policySpec.SetPollInterval(time.Millisecond)
+		return vaultSpec, policySpec
+	}
+	It(`Invoke EnsureBucketBackedUp creating the vault and the policy`, func() {
+		vaultSpec, policySpec := newSpecs()
+		policy, vault, operationErr := resourceConfigurationService.EnsureBucketBackedUp(context.Background(), "my-bucket", vaultSpec, policySpec)
+		Expect(operationErr).To(BeNil())
+		Expect(*policy.PolicyStatus).To(Equal("active"))
+		Expect(*policy.PolicyID).To(Equal("new-policy"))
+		Expect(*vault.Crn).To(Equal(vaultCRN))
+		Expect(vaultBody).To(Equal(map[string]interface{}{
+			"backup_vault_name":            "my-vault",
+			"region":                       "us-south",
+			"activity_tracking":            map[string]interface{}{"management_events": true},
+			"metrics_monitoring":           map[string]interface{}{"usage_metrics_enabled": true},
+			"sse_kp_customer_root_key_crn": "testKey",
+		}))
+		Expect(requests).To(Equal([]string{
+			"GET /backup_vaults/my-vault",
+			"POST /backup_vaults",
+			"GET /buckets/my-bucket/backup_policies",
+			"POST /buckets/my-bucket/backup_policies",
+			"GET /buckets/my-bucket/backup_policies/new-policy",
+			"GET /buckets/my-bucket/backup_policies/new-policy",
+			"GET /buckets/my-bucket/backup_policies/new-policy",
+		}))
+	})
+	It(`Invoke EnsureBucketBackedUp with an existing vault and policy`, func() {
+		vaultExists = true
+		policies = []map[string]interface{}{
+			{"policy_id": "old-policy", "policy_name": "daily", "target_backup_vault_crn": vaultCRN, "backup_type": "continuous", "initial_retention": map[string]interface{}{"delete_after_days": 1}, "policy_status": "active"},
+		}
+		policyStatuses = []string{"active"}
+		vaultSpec, policySpec := newSpecs()
+		policy, vault, operationErr := resourceConfigurationService.EnsureBucketBackedUp(context.Background(), "my-bucket", vaultSpec, policySpec)
+		Expect(operationErr).To(BeNil())
+		Expect(*policy.PolicyID).To(Equal("old-policy"))
+		Expect(*vault.BackupVaultName).To(Equal("my-vault"))
+		Expect(requests).To(Equal([]string{
+			"GET /backup_vaults/my-vault",
+			"GET /buckets/my-bucket/backup_policies",
+			"GET /buckets/my-bucket/backup_policies/old-policy",
+		}))
+	})
+	It(`Invoke EnsureBucketBackedUp with error: policy creation fails`, func() {
+		createPolicyStatus = 400
+		vaultSpec, policySpec := newSpecs()
+		policy, vault, operationErr := resourceConfigurationService.EnsureBucketBackedUp(context.Background(), "my-bucket", vaultSpec, policySpec)
+		Expect(operationErr).ToNot(BeNil())
+		Expect(operationErr.Error()).To(ContainSubstring("versioning"))
+		Expect(policy).To(BeNil())
+		Expect(vault).To(BeNil())
+		Expect(requests[len(requests)-1]).To(Equal("DELETE /backup_vaults/my-vault"))
+		Expect(vaultExists).To(BeFalse())
+	})
+	It(`Invoke EnsureBucketBackedUp with error: policy fails`, func() {
+		policyStatuses = []string{"initializing", "failed"}
+		vaultSpec, policySpec := newSpecs()
+		_, _, operationErr := resourceConfigurationService.EnsureBucketBackedUp(context.Background(), "my-bucket", vaultSpec, policySpec)
+		Expect(operationErr).ToNot(BeNil())
+		Expect(operationErr.Error()).To(ContainSubstring("new-policy is failed"))
+		var sdkProblem *core.SDKProblem
+		Expect(errors.As(operationErr, &sdkProblem)).To(BeTrue())
+		Expect(operationErr.Error()).ToNot(ContainSubstring("created by this call"))
+		Expect(requests[len(requests)-2:]).To(Equal([]string{
+			"DELETE /buckets/my-bucket/backup_policies/new-policy",
+			"DELETE /backup_vaults/my-vault",
+		}))
+	})
+	It(`Invoke EnsureBucketBackedUp with error: the created vault cannot be deleted`, func() {
+		policyStatuses = []string{"initializing", "failed"}
+		vaultHasRanges = true
+		vaultSpec, policySpec := newSpecs()
+		_, _, operationErr := resourceConfigurationService.EnsureBucketBackedUp(context.Background(), "my-bucket", vaultSpec, policySpec)
+		Expect(operationErr).ToNot(BeNil())
+		Expect(operationErr.Error()).To(ContainSubstring("new-policy is failed"))
+		Expect(operationErr.Error()).To(ContainSubstring("unable to delete backup vault my-vault created by this call"))
+		Expect(operationErr.Error()).To(ContainSubstring("backup vault contains recovery ranges"))
+		Expect(operationErr.Error()).ToNot(ContainSubstring("unable to delete backup policy"))
+		var sdkProblem *core.SDKProblem
+		Expect(errors.As(operationErr, &sdkProblem)).To(BeTrue())
+		Expect(vaultExists).To(BeTrue())
+	})
+	It(`Invoke EnsureBucketBackedUp with error: policy targets another vault`, func() {
+		vaultExists = true
+		policies = []map[string]interface{}{
+			{"policy_id": "old-policy", "policy_name": "daily", "target_backup_vault_crn": "testString", "backup_type": "continuous", "initial_retention": map[string]interface{}{"delete_after_days": 1}, "policy_status": "active"},
+		}
+		vaultSpec, policySpec := newSpecs()
+		_, _, operationErr := resourceConfigurationService.EnsureBucketBackedUp(context.Background(), "my-bucket", vaultSpec, policySpec)
+		Expect(operationErr).ToNot(BeNil())
+		Expect(operationErr.Error()).To(ContainSubstring("targets testString"))
+		// The vault existed before the call, so it is kept.
+		Expect(vaultExists).To(BeTrue())
+		Expect(requests).ToNot(ContainElement("DELETE /backup_vaults/my-vault"))
+	})
+	It(`Invoke EnsureBucketBackedUp with error: Operation validation error`, func() {
+		vaultSpec, policySpec := newSpecs()
+		_, _, operationErr := resourceConfigurationService.EnsureBucketBackedUp(context.Background(), "my-bucket", nil, policySpec)
+		Expect(operationErr).ToNot(BeNil())
+		_, _, operationErr = resourceConfigurationService.EnsureBucketBackedUp(context.Background(), "my-bucket", vaultSpec, nil)
+		Expect(operationErr).ToNot(BeNil())
+		_, _, operationErr = resourceConfigurationService.EnsureBucketBackedUp(context.Background(), "my-bucket", new(resourceconfigurationv1.BackupVaultSpec), policySpec)
+		Expect(operationErr).ToNot(BeNil())
+		_, _, operationErr = resourceConfigurationService.EnsureBucketBackedUp(context.Background(), "", vaultSpec, policySpec)
+		Expect(operationErr).ToNot(BeNil())
+		Expect(requests).To(BeEmpty())
+	})
+})
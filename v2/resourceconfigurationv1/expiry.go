@@ -0,0 +1,278 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourceconfigurationv1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/ibm-cos-sdk-go-config/v2/common"
+	"github.com/go-openapi/strfmt"
+)
+
+// DefaultRetentionCalendarWithin is how far ahead a retention calendar looks when it is not given a window.
+const DefaultRetentionCalendarWithin = 30 * 24 * time.Hour
+
+// retentionDay is the unit of DeleteAfterDays.
+const retentionDay = 24 * time.Hour
+
+// HasIndefiniteRetention reports whether the recovery points of the RecoveryRange are retained indefinitely.
+func (recoveryRange *RecoveryRange) HasIndefiniteRetention() bool {
+	return recoveryRange.Retention != nil && recoveryRange.Retention.DeleteAfterDays != nil &&
+		*recoveryRange.Retention.DeleteAfterDays == IndefiniteRetentionDays
+}
+
+// retention returns the retention of the RecoveryRange, or false if it is unknown or indefinite.
+func (recoveryRange *RecoveryRange) retention() (time.Duration, bool) {
+	if recoveryRange.Retention == nil || recoveryRange.Retention.DeleteAfterDays == nil || recoveryRange.HasIndefiniteRetention() {
+		return 0, false
+	}
+	return time.Duration(*recoveryRange.Retention.DeleteAfterDays) * retentionDay, true
+}
+
+// ExpiresAt returns the time the last recovery point of the RecoveryRange ages out: its range_end_time plus its
+// retention. It returns false when the retention is indefinite or the range lacks an end time or a retention. For a
+// range that is still being extended the expiry moves forward as the range does.
+func (recoveryRange *RecoveryRange) ExpiresAt() (time.Time, bool) {
+	retention, ok := recoveryRange.retention()
+	if !ok || recoveryRange.RangeEndTime == nil {
+		return time.Time{}, false
+	}
+	return time.Time(*recoveryRange.RangeEndTime).Add(retention), true
+}
+
+// EarliestRecoverablePoint returns the earliest point in time the RecoveryRange can restore to at the time "now":
+// its range_start_time, or range_create_time if it has no start time, unless recovery points older than the retention
+// have aged out by then. It returns false when the range lacks a start time or has expired entirely.
+func (recoveryRange *RecoveryRange) EarliestRecoverablePoint(now time.Time) (time.Time, bool) {
+	start := recoveryRange.RangeStartTime
+	if start == nil {
+		start = recoveryRange.RangeCreateTime
+	}
+	if start == nil {
+		return time.Time{}, false
+	}
+	earliest := time.Time(*start)
+	retention, ok := recoveryRange.retention()
+	if !ok {
+		return earliest, true
+	}
+	if agedOut := now.Add(-retention); agedOut.After(earliest) {
+		earliest = agedOut
+	}
+	if recoveryRange.RangeEndTime != nil && earliest.After(time.Time(*recoveryRange.RangeEndTime)) {
+		return time.Time{}, false
+	}
+	return earliest, true
+}
+
+// RetentionCalendarOptions : The GetRetentionCalendar options.
+type RetentionCalendarOptions struct {
+	// Name of the service_instance whose BackupVaults are searched.
+	ServiceInstanceID *string `json:"service_instance_id" validate:"required,ne="`
+
+	// How far ahead to look for expiring RecoveryRanges. Defaults to DefaultRetentionCalendarWithin.
+	Within time.Duration
+
+	// The time expiry is measured from. Defaults to the current time.
+	Now time.Time
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewRetentionCalendarOptions : Instantiate RetentionCalendarOptions
+func (*ResourceConfigurationV1) NewRetentionCalendarOptions(serviceInstanceID string) *RetentionCalendarOptions {
+	return &RetentionCalendarOptions{
+		ServiceInstanceID: core.StringPtr(serviceInstanceID),
+	}
+}
+
+// SetWithin : Allow user to set Within
+func (_options *RetentionCalendarOptions) SetWithin(within time.Duration) *RetentionCalendarOptions {
+	_options.Within = within
+	return _options
+}
+
+// SetNow : Allow user to set Now
+func (_options *RetentionCalendarOptions) SetNow(now time.Time) *RetentionCalendarOptions {
+	_options.Now = now
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *RetentionCalendarOptions) SetHeaders(param map[string]string) *RetentionCalendarOptions {
+	options.Headers = param
+	return options
+}
+
+// RetentionCalendar : The RecoveryRanges of a service instance that expire within a window.
+type RetentionCalendar struct {
+	// The time expiry is measured from.
+	GeneratedAt *strfmt.DateTime `json:"generated_at"`
+
+	// The end of the window.
+	Until *strfmt.DateTime `json:"until"`
+
+	// The expiring RecoveryRanges, soonest first.
+	Entries []RetentionCalendarEntry `json:"entries"`
+}
+
+// RetentionCalendarEntry : A RecoveryRange that expires within the window of a retention calendar.
+type RetentionCalendarEntry struct {
+	// The name of the BackupVault that holds the RecoveryRange.
+	BackupVaultName string `json:"backup_vault_name"`
+
+	// The RecoveryRange.
+	RecoveryRange *RecoveryRange `json:"recovery_range"`
+
+	// The earliest point in time the RecoveryRange can restore to now.
+	EarliestRecoverablePoint *strfmt.DateTime `json:"earliest_recoverable_point,omitempty"`
+
+	// The time the last recovery point of the RecoveryRange ages out.
+	ExpiresAt *strfmt.DateTime `json:"expires_at"`
+
+	// The time until ExpiresAt, in seconds.
+	ExpiresInSeconds int64 `json:"expires_in_seconds"`
+}
+
+// WriteJSON writes the calendar to "w" as indented JSON.
+func (calendar *RetentionCalendar) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(calendar)
+}
+
+// WriteTable writes the calendar to "w" as aligned columns, one row per expiring RecoveryRange.
+func (calendar *RetentionCalendar) WriteTable(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 3, ' ', 0)
+	fmt.Fprintln(tw, "EXPIRES\tIN\tVAULT\tRANGE\tSOURCE\tRETENTION\tEARLIEST RECOVERABLE")
+	for _, entry := range calendar.Entries {
+		var retention *int64
+		if entry.RecoveryRange.Retention != nil {
+			retention = entry.RecoveryRange.Retention.DeleteAfterDays
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", formatDateTime(entry.ExpiresAt),
+			(time.Duration(entry.ExpiresInSeconds) * time.Second).String(), entry.BackupVaultName,
+			orDash(entry.RecoveryRange.RecoveryRangeID), orDash(entry.RecoveryRange.SourceResourceCrn),
+			formatRetentionDays(retention), formatDateTime(entry.EarliestRecoverablePoint))
+	}
+	return tw.Flush()
+}
+
+// GetRetentionCalendar : List the RecoveryRanges that expire soon
+// Searches every BackupVault of the service instance for RecoveryRanges whose last recovery point ages out before the
+// end of the window, so that their retention can be extended in time. Ranges with indefinite retention never expire,
+// and ranges that have already expired can no longer be extended, so neither is listed. The entries are ordered by
+// expiry, soonest first.
+func (resourceConfiguration *ResourceConfigurationV1) GetRetentionCalendar(retentionCalendarOptions *RetentionCalendarOptions) (result *RetentionCalendar, err error) {
+	result, err = resourceConfiguration.GetRetentionCalendarWithContext(context.Background(), retentionCalendarOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetRetentionCalendarWithContext is an alternate form of the GetRetentionCalendar method which supports a Context parameter
+func (resourceConfiguration *ResourceConfigurationV1) GetRetentionCalendarWithContext(ctx context.Context, retentionCalendarOptions *RetentionCalendarOptions) (result *RetentionCalendar, err error) {
+	err = core.ValidateNotNil(retentionCalendarOptions, "retentionCalendarOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(retentionCalendarOptions, "retentionCalendarOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	now := retentionCalendarOptions.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+	within := retentionCalendarOptions.Within
+	if within <= 0 {
+		within = DefaultRetentionCalendarWithin
+	}
+	until := now.Add(within)
+
+	listBackupVaultsOptions := resourceConfiguration.NewListBackupVaultsOptions(*retentionCalendarOptions.ServiceInstanceID)
+	listBackupVaultsOptions.SetHeaders(retentionCalendarOptions.Headers)
+	vaultsPager, err := resourceConfiguration.NewBackupVaultsPager(listBackupVaultsOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "calendar-list-backup-vaults-error")
+		return
+	}
+	vaults, err := vaultsPager.GetAllWithContext(ctx)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "calendar-list-backup-vaults-error")
+		return
+	}
+
+	entries := []RetentionCalendarEntry{}
+	for _, vault := range vaults {
+		listRecoveryRangesOptions := resourceConfiguration.NewListRecoveryRangesOptions(vault)
+		listRecoveryRangesOptions.SetHeaders(retentionCalendarOptions.Headers)
+		var rangesPager *RecoveryRangesPager
+		rangesPager, err = resourceConfiguration.NewRecoveryRangesPager(listRecoveryRangesOptions)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "calendar-list-recovery-ranges-error")
+			return
+		}
+		var ranges []RecoveryRange
+		ranges, err = rangesPager.GetAllWithContext(ctx)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "calendar-list-recovery-ranges-error")
+			return
+		}
+		for i := range ranges {
+			expiresAt, ok := ranges[i].ExpiresAt()
+			if !ok || expiresAt.Before(now) || expiresAt.After(until) {
+				continue
+			}
+			entry := RetentionCalendarEntry{
+				BackupVaultName:  vault,
+				RecoveryRange:    &ranges[i],
+				ExpiresAt:        dateTimePtr(expiresAt),
+				ExpiresInSeconds: int64(expiresAt.Sub(now) / time.Second),
+			}
+			if earliest, ok := ranges[i].EarliestRecoverablePoint(now); ok {
+				entry.EarliestRecoverablePoint = dateTimePtr(earliest)
+			}
+			entries = append(entries, entry)
+		}
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return time.Time(*entries[i].ExpiresAt).Before(time.Time(*entries[j].ExpiresAt))
+	})
+	result = &RetentionCalendar{
+		GeneratedAt: dateTimePtr(now),
+		Until:       dateTimePtr(until),
+		Entries:     entries,
+	}
+	return
+}
+
+func dateTimePtr(t time.Time) *strfmt.DateTime {
+	dateTime := strfmt.DateTime(t.UTC())
+	return &dateTime
+}
@@ -0,0 +1,171 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourceconfigurationv1_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/ibm-cos-sdk-go-config/v2/resourceconfigurationv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ResourceConfigurationV1 retention expiry`, func() {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	recoveryRange := func(start string, end string, days *int64) *resourceconfigurationv1.RecoveryRange {
+		recoveryRange := &resourceconfigurationv1.RecoveryRange{}
+		if start != "" {
+			recoveryRange.RangeStartTime = CreateMockDateTime(start)
+		}
+		if end != "" {
+			recoveryRange.RangeEndTime = CreateMockDateTime(end)
+		}
+		if days != nil {
+			recoveryRange.Retention = &resourceconfigurationv1.DeleteAfterDaysWithIndefinite{DeleteAfterDays: days}
+		}
+		return recoveryRange
+	}
+
+	Describe(`RecoveryRange expiry helpers`, func() {
+		It(`Calculate the expiry and earliest recoverable point`, func() {
+			r := recoveryRange("2025-04-01T00:00:00.000Z", "2025-05-10T00:00:00.000Z", core.Int64Ptr(30))
+			Expect(r.HasIndefiniteRetention()).To(BeFalse())
+			expiresAt, ok := r.ExpiresAt()
+			Expect(ok).To(BeTrue())
+			Expect(expiresAt).To(Equal(time.Date(2025, 6, 9, 0, 0, 0, 0, time.UTC)))
+			earliest, ok := r.EarliestRecoverablePoint(now)
+			Expect(ok).To(BeTrue())
+			Expect(earliest).To(Equal(time.Date(2025, 5, 2, 0, 0, 0, 0, time.UTC)))
+			earliest, ok = r.EarliestRecoverablePoint(time.Date(2025, 4, 15, 0, 0, 0, 0, time.UTC))
+			Expect(ok).To(BeTrue())
+			Expect(earliest).To(Equal(time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)))
+			_, ok = r.EarliestRecoverablePoint(time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC))
+			Expect(ok).To(BeFalse())
+		})
+		It(`Handle indefinite and missing retention`, func() {
+			r := recoveryRange("2025-04-01T00:00:00.000Z", "2025-05-10T00:00:00.000Z", core.Int64Ptr(-1))
+			Expect(r.HasIndefiniteRetention()).To(BeTrue())
+			_, ok := r.ExpiresAt()
+			Expect(ok).To(BeFalse())
+			earliest, ok := r.EarliestRecoverablePoint(now)
+			Expect(ok).To(BeTrue())
+			Expect(earliest).To(Equal(time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)))
+
+			r = recoveryRange("", "2025-05-10T00:00:00.000Z", nil)
+			_, ok = r.ExpiresAt()
+			Expect(ok).To(BeFalse())
+			_, ok = r.EarliestRecoverablePoint(now)
+			Expect(ok).To(BeFalse())
+
+			r.RangeCreateTime = CreateMockDateTime("2025-03-01T00:00:00.000Z")
+			earliest, ok = r.EarliestRecoverablePoint(now)
+			Expect(ok).To(BeTrue())
+			Expect(earliest).To(Equal(time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)))
+		})
+	})
+
+	Describe(`GetRetentionCalendar(retentionCalendarOptions *RetentionCalendarOptions)`, func() {
+		var testServer *httptest.Server
+		var resourceConfigurationService *resourceconfigurationv1.ResourceConfigurationV1
+		BeforeEach(func() {
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				Expect(req.Method).To(Equal("GET"))
+				res.Header().Set("Content-type", "application/json")
+				switch req.URL.EscapedPath() {
+				case "/backup_vaults":
+					Expect(req.URL.Query().Get("service_instance_id")).To(Equal("testInstance"))
+					fmt.Fprint(res, `{"backup_vaults": ["vault-a", "vault-b"]}`)
+				case "/backup_vaults/vault-a/recovery_ranges":
+					fmt.Fprint(res, `{"recovery_ranges": [
+						{"recovery_range_id": "r1", "source_resource_crn": "bucket-1", "range_start_time": "2025-04-01T00:00:00.000Z", "range_end_time": "2025-05-10T00:00:00.000Z", "retention": {"delete_after_days": 30}},
+						{"recovery_range_id": "r2", "source_resource_crn": "bucket-1", "range_start_time": "2025-01-01T00:00:00.000Z", "range_end_time": "2025-02-01T00:00:00.000Z", "retention": {"delete_after_days": -1}},
+						{"recovery_range_id": "r3", "source_resource_crn": "bucket-2", "range_start_time": "2025-05-01T00:00:00.000Z", "range_end_time": "2025-06-01T00:00:00.000Z", "retention": {"delete_after_days": 90}}
+					]}`)
+				case "/backup_vaults/vault-b/recovery_ranges":
+					fmt.Fprint(res, `{"recovery_ranges": [
+						{"recovery_range_id": "r4", "source_resource_crn": "bucket-3", "range_start_time": "2025-05-01T00:00:00.000Z", "range_end_time": "2025-05-20T00:00:00.000Z", "retention": {"delete_after_days": 7}},
+						{"recovery_range_id": "r5", "source_resource_crn": "bucket-3", "range_start_time": "2025-05-01T00:00:00.000Z", "range_end_time": "2025-05-20T00:00:00.000Z"}
+					]}`)
+				default:
+					Fail("unexpected request " + req.URL.Path)
+				}
+			}))
+			var serviceErr error
+			resourceConfigurationService, serviceErr = resourceconfigurationv1.NewResourceConfigurationV1(&resourceconfigurationv1.ResourceConfigurationV1Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+		})
+		AfterEach(func() {
+			testServer.Close()
+		})
+		It(`Invoke GetRetentionCalendar successfully`, func() {
+			retentionCalendarOptionsModel := resourceConfigurationService.NewRetentionCalendarOptions("testInstance")
+			retentionCalendarOptionsModel.SetNow(now)
+			calendar, operationErr := resourceConfigurationService.GetRetentionCalendar(retentionCalendarOptionsModel)
+			Expect(operationErr).To(BeNil())
+			Expect(calendar.Until.String()).To(Equal("2025-07-01T00:00:00.000Z"))
+			// r4 expired on 2025-05-27 and is not listed.
+			Expect(calendar.Entries).To(HaveLen(1))
+
+			Expect(*calendar.Entries[0].RecoveryRange.RecoveryRangeID).To(Equal("r1"))
+			Expect(calendar.Entries[0].BackupVaultName).To(Equal("vault-a"))
+			Expect(calendar.Entries[0].ExpiresAt.String()).To(Equal("2025-06-09T00:00:00.000Z"))
+			Expect(calendar.Entries[0].ExpiresInSeconds).To(Equal(int64(8 * 24 * 3600)))
+			Expect(calendar.Entries[0].EarliestRecoverablePoint.String()).To(Equal("2025-05-02T00:00:00.000Z"))
+
+			var table bytes.Buffer
+			Expect(calendar.WriteTable(&table)).To(Succeed())
+			lines := strings.Split(strings.TrimSpace(table.String()), "\n")
+			Expect(lines).To(HaveLen(2))
+			Expect(lines[0]).To(MatchRegexp(`^EXPIRES\s+IN\s+VAULT\s+RANGE\s+SOURCE\s+RETENTION\s+EARLIEST RECOVERABLE$`))
+			Expect(lines[1]).To(MatchRegexp(`^2025-06-09T00:00:00Z\s+192h0m0s\s+vault-a\s+r1\s+bucket-1\s+30\s+2025-05-02T00:00:00Z$`))
+
+			retentionCalendarOptionsModel.SetWithin(100 * 24 * time.Hour)
+			calendar, operationErr = resourceConfigurationService.GetRetentionCalendar(retentionCalendarOptionsModel)
+			Expect(operationErr).To(BeNil())
+			Expect(calendar.Entries).To(HaveLen(2))
+			Expect(*calendar.Entries[1].RecoveryRange.RecoveryRangeID).To(Equal("r3"))
+			for _, entry := range calendar.Entries {
+				Expect(entry.ExpiresInSeconds).To(BeNumerically(">=", 0))
+			}
+		})
+		It(`Invoke GetRetentionCalendar with error`, func() {
+			calendar, operationErr := resourceConfigurationService.GetRetentionCalendar(nil)
+			Expect(operationErr).ToNot(BeNil())
+			Expect(calendar).To(BeNil())
+
+			calendar, operationErr = resourceConfigurationService.GetRetentionCalendar(resourceConfigurationService.NewRetentionCalendarOptions(""))
+			Expect(operationErr).ToNot(BeNil())
+			Expect(calendar).To(BeNil())
+
+			resourceConfigurationService.SetServiceURL("http://localhost:1")
+			calendar, operationErr = resourceConfigurationService.GetRetentionCalendar(resourceConfigurationService.NewRetentionCalendarOptions("testInstance"))
+			Expect(operationErr).ToNot(BeNil())
+			Expect(calendar).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,355 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourceconfigurationv1
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/ibm-cos-sdk-go-config/v2/common"
+)
+
+// Defaults used by EnableFailover.
+const (
+	DefaultFailoverFailureThreshold = 3
+	DefaultFailoverCoolDown         = 30 * time.Second
+	DefaultFailoverHealthTimeout    = 10 * time.Second
+)
+
+// FailoverOptions : The EnableFailover options.
+type FailoverOptions struct {
+	// The service URLs to use, in order of preference, e.g. the private, direct and public endpoints of a region.
+	ServiceURLs []string `validate:"required,min=1,dive,required"`
+
+	// The number of consecutive 5xx responses after which an endpoint is taken out of use.
+	// Defaults to DefaultFailoverFailureThreshold.
+	FailureThreshold int
+
+	// The time an endpoint stays out of use before it is health checked again. Defaults to DefaultFailoverCoolDown.
+	CoolDown time.Duration
+
+	// Checks whether a failed endpoint can be used again. The default sends a GET request to the service URL and
+	// accepts any response below 500.
+	HealthCheck func(ctx context.Context, serviceURL string) error
+}
+
+// NewFailoverOptions : Instantiate FailoverOptions
+func (*ResourceConfigurationV1) NewFailoverOptions(serviceURLs []string) *FailoverOptions {
+	return &FailoverOptions{
+		ServiceURLs: serviceURLs,
+	}
+}
+
+// SetFailureThreshold : Allow user to set FailureThreshold
+func (_options *FailoverOptions) SetFailureThreshold(failureThreshold int) *FailoverOptions {
+	_options.FailureThreshold = failureThreshold
+	return _options
+}
+
+// SetCoolDown : Allow user to set CoolDown
+func (_options *FailoverOptions) SetCoolDown(coolDown time.Duration) *FailoverOptions {
+	_options.CoolDown = coolDown
+	return _options
+}
+
+// SetHealthCheck : Allow user to set HealthCheck
+func (_options *FailoverOptions) SetHealthCheck(healthCheck func(ctx context.Context, serviceURL string) error) *FailoverOptions {
+	_options.HealthCheck = healthCheck
+	return _options
+}
+
+// EnableFailover : Spread requests over an ordered list of service URLs
+// Requests go to the first endpoint in use. An endpoint is taken out of use when a request fails to connect to it,
+// in which case the request is sent to the next endpoint, or after FailureThreshold consecutive 5xx responses. It is
+// health checked once CoolDown has passed and used again when the check succeeds.
+//
+// The service URL is set to the first of the ServiceURLs. Requests sent to any of the ServiceURLs, including those of
+// clones of "resourceConfiguration", which share its endpoint state, are subject to failover; requests to other URLs
+// set with SetServiceURL are sent unchanged. Call EnableFailover after DisableSSLVerification and SetHTTPClient.
+func (resourceConfiguration *ResourceConfigurationV1) EnableFailover(failoverOptions *FailoverOptions) error {
+	err := core.ValidateNotNil(failoverOptions, "failoverOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return err
+	}
+	err = core.ValidateStruct(failoverOptions, "failoverOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return err
+	}
+
+	transport := &failoverTransport{
+		failureThreshold: failoverOptions.FailureThreshold,
+		coolDown:         failoverOptions.CoolDown,
+		healthCheck:      failoverOptions.HealthCheck,
+	}
+	if transport.failureThreshold <= 0 {
+		transport.failureThreshold = DefaultFailoverFailureThreshold
+	}
+	if transport.coolDown <= 0 {
+		transport.coolDown = DefaultFailoverCoolDown
+	}
+	for _, serviceURL := range failoverOptions.ServiceURLs {
+		if _, err = url.Parse(serviceURL); err != nil {
+			err = core.SDKErrorf(err, fmt.Sprintf("invalid service URL %q", serviceURL), "invalid-failover-url", common.GetComponentInfo())
+			return err
+		}
+		transport.endpoints = append(transport.endpoints, &failoverEndpoint{url: strings.TrimSuffix(serviceURL, "/")})
+	}
+
+	err = resourceConfiguration.Service.SetServiceURL(failoverOptions.ServiceURLs[0])
+	if err != nil {
+		err = core.SDKErrorf(err, "", "url-set-error", common.GetComponentInfo())
+		return err
+	}
+
+	client := resourceConfiguration.Service.GetHTTPClient()
+	transport.next = client.Transport
+	if existing, ok := transport.next.(*failoverTransport); ok {
+		transport.next = existing.next
+	}
+	if transport.next == nil {
+		transport.next = http.DefaultTransport
+	}
+	if transport.healthCheck == nil {
+		transport.healthCheck = transport.defaultHealthCheck
+	}
+	client.Transport = transport
+	return nil
+}
+
+// DisableFailover : Send every request to the service URL again
+func (resourceConfiguration *ResourceConfigurationV1) DisableFailover() {
+	client := resourceConfiguration.Service.GetHTTPClient()
+	if transport, ok := client.Transport.(*failoverTransport); ok {
+		client.Transport = transport.next
+	}
+}
+
+// GetActiveServiceURL returns the service URL that requests are currently sent to. Without failover, this is the
+// service URL.
+func (resourceConfiguration *ResourceConfigurationV1) GetActiveServiceURL() string {
+	serviceURL := resourceConfiguration.Service.GetServiceURL()
+	transport, ok := resourceConfiguration.Service.GetHTTPClient().Transport.(*failoverTransport)
+	if !ok {
+		return serviceURL
+	}
+	if _, _, ok = transport.match(serviceURL); !ok {
+		return serviceURL
+	}
+	return transport.active()
+}
+
+// failoverEndpoint is the state of one of the failover service URLs.
+type failoverEndpoint struct {
+	url string
+
+	// The number of consecutive 5xx responses.
+	failures int
+
+	// When the endpoint was taken out of use; zero while it is in use.
+	downSince time.Time
+
+	// Whether a health check is running.
+	checking bool
+}
+
+// failoverTransport rewrites requests addressed to any of the endpoints to the preferred endpoint in use.
+type failoverTransport struct {
+	next             http.RoundTripper
+	endpoints        []*failoverEndpoint
+	failureThreshold int
+	coolDown         time.Duration
+	healthCheck      func(ctx context.Context, serviceURL string) error
+
+	mutex sync.Mutex
+}
+
+func (t *failoverTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	_, suffix, ok := t.match(req.URL.String())
+	if !ok {
+		return t.next.RoundTrip(req)
+	}
+
+	tried := make(map[int]bool)
+	for {
+		i := t.pick(tried)
+		tried[i] = true
+
+		attempt, err := t.rewrite(req, t.endpoints[i].url+suffix, len(tried) > 1)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := t.next.RoundTrip(attempt)
+		if err != nil {
+			if req.Context().Err() != nil {
+				return nil, err
+			}
+			t.markDown(i, err.Error())
+			canReplay := req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+			if len(tried) == len(t.endpoints) || !canReplay {
+				return nil, err
+			}
+			continue
+		}
+
+		t.record(i, resp.StatusCode)
+		return resp, nil
+	}
+}
+
+// match returns the index of the endpoint that "requestURL" is addressed to and the rest of the URL after it.
+func (t *failoverTransport) match(requestURL string) (index int, suffix string, ok bool) {
+	longest := -1
+	for i, endpoint := range t.endpoints {
+		if !strings.HasPrefix(requestURL, endpoint.url) {
+			continue
+		}
+		rest := requestURL[len(endpoint.url):]
+		if rest != "" && rest[0] != '/' && rest[0] != '?' {
+			continue
+		}
+		if longest < 0 || len(endpoint.url) > len(t.endpoints[longest].url) {
+			longest, suffix = i, rest
+		}
+	}
+	return longest, suffix, longest >= 0
+}
+
+// pick returns the first endpoint in use that is not in "tried". When every such endpoint is out of use, it returns
+// the first one not in "tried" so that requests are still attempted; at least one endpoint must not be in "tried". Endpoints whose cool-down has passed are
+// health checked in the background.
+func (t *failoverTransport) pick(tried map[int]bool) int {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	fallback := -1
+	for i, endpoint := range t.endpoints {
+		if tried[i] {
+			continue
+		}
+		if endpoint.downSince.IsZero() {
+			return i
+		}
+		if fallback < 0 {
+			fallback = i
+		}
+		if !endpoint.checking && time.Since(endpoint.downSince) >= t.coolDown {
+			endpoint.checking = true
+			go t.check(endpoint)
+		}
+	}
+	return fallback
+}
+
+// active returns the URL of the first endpoint in use, or of the first endpoint when none are in use.
+func (t *failoverTransport) active() string {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	for _, endpoint := range t.endpoints {
+		if endpoint.downSince.IsZero() {
+			return endpoint.url
+		}
+	}
+	return t.endpoints[0].url
+}
+
+func (t *failoverTransport) check(endpoint *failoverEndpoint) {
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultFailoverHealthTimeout)
+	defer cancel()
+	err := t.healthCheck(ctx, endpoint.url)
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	endpoint.checking = false
+	if err != nil {
+		endpoint.downSince = time.Now()
+		core.GetLogger().Warn("Endpoint %s failed its health check: %s\n", endpoint.url, err.Error())
+		return
+	}
+	endpoint.downSince = time.Time{}
+	endpoint.failures = 0
+	core.GetLogger().Info("Endpoint %s passed its health check and is back in use\n", endpoint.url)
+}
+
+func (t *failoverTransport) defaultHealthCheck(ctx context.Context, serviceURL string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, serviceURL, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode >= 500 {
+		return fmt.Errorf("health check returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func (t *failoverTransport) markDown(i int, reason string) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.markDownLocked(t.endpoints[i], reason)
+}
+
+func (t *failoverTransport) markDownLocked(endpoint *failoverEndpoint, reason string) {
+	if endpoint.downSince.IsZero() {
+		core.GetLogger().Warn("Taking endpoint %s out of use: %s\n", endpoint.url, reason)
+	}
+	endpoint.downSince = time.Now()
+	endpoint.failures = 0
+}
+
+func (t *failoverTransport) record(i int, statusCode int) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	endpoint := t.endpoints[i]
+	if statusCode < 500 {
+		endpoint.failures = 0
+		return
+	}
+	endpoint.failures++
+	if endpoint.failures >= t.failureThreshold {
+		t.markDownLocked(endpoint, fmt.Sprintf("%d consecutive responses with status 5xx", endpoint.failures))
+	}
+}
+
+// rewrite returns a copy of "req" addressed to "target". A request that is sent again gets a fresh copy of its body.
+func (t *failoverTransport) rewrite(req *http.Request, target string, resend bool) (*http.Request, error) {
+	targetURL, err := url.Parse(target)
+	if err != nil {
+		return nil, err
+	}
+	attempt := req.Clone(req.Context())
+	attempt.URL = targetURL
+	attempt.Host = targetURL.Host
+	if resend && req.GetBody != nil {
+		attempt.Body, err = req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return attempt, nil
+}
@@ -0,0 +1,157 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourceconfigurationv1_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/ibm-cos-sdk-go-config/v2/resourceconfigurationv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ResourceConfigurationV1 failover`, func() {
+	var primaryServer, secondaryServer *httptest.Server
+	var primaryStatus int32
+	var primaryRequests, secondaryRequests int32
+	var secondaryBody string
+	var resourceConfigurationService *resourceconfigurationv1.ResourceConfigurationV1
+	vaultHandler := func(requests *int32, status *int32) http.HandlerFunc {
+		return func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			atomic.AddInt32(requests, 1)
+			if status != nil && atomic.LoadInt32(status) != 200 {
+				res.WriteHeader(int(atomic.LoadInt32(status)))
+				return
+			}
+			if req.Method == "POST" {
+				body, _ := io.ReadAll(req.Body)
+				secondaryBody = string(body)
+			}
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			fmt.Fprint(res, `{"backup_vault_name": "testString"}`)
+		}
+	}
+	BeforeEach(func() {
+		atomic.StoreInt32(&primaryStatus, 200)
+		atomic.StoreInt32(&primaryRequests, 0)
+		atomic.StoreInt32(&secondaryRequests, 0)
+		secondaryBody = ""
+		primaryServer = httptest.NewServer(vaultHandler(&primaryRequests, &primaryStatus))
+		secondaryServer = httptest.NewServer(vaultHandler(&secondaryRequests, nil))
+
+		var serviceErr error
+		resourceConfigurationService, serviceErr = resourceconfigurationv1.NewResourceConfigurationV1(&resourceconfigurationv1.ResourceConfigurationV1Options{
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		primaryServer.Close()
+		secondaryServer.Close()
+	})
+	It(`Fail over to the next endpoint on connection errors`, func() {
+		primaryServer.Close()
+		failoverOptions := resourceConfigurationService.NewFailoverOptions([]string{primaryServer.URL, secondaryServer.URL})
+		Expect(resourceConfigurationService.EnableFailover(failoverOptions)).To(Succeed())
+		Expect(resourceConfigurationService.GetServiceURL()).To(Equal(primaryServer.URL))
+
+		result, response, operationErr := resourceConfigurationService.GetBackupVault(resourceConfigurationService.NewGetBackupVaultOptions("testString"))
+		Expect(operationErr).To(BeNil())
+		Expect(response.StatusCode).To(Equal(200))
+		Expect(*result.BackupVaultName).To(Equal("testString"))
+		Expect(resourceConfigurationService.GetActiveServiceURL()).To(Equal(secondaryServer.URL))
+
+		// Request bodies are sent again to the next endpoint.
+		createBackupVaultOptionsModel := resourceConfigurationService.NewCreateBackupVaultOptions("testString", "testString", "us-south")
+		clone := resourceConfigurationService.Clone()
+		Expect(clone.GetActiveServiceURL()).To(Equal(secondaryServer.URL))
+		_, _, operationErr = clone.CreateBackupVault(createBackupVaultOptionsModel)
+		Expect(operationErr).To(BeNil())
+		Expect(secondaryBody).To(ContainSubstring(`"backup_vault_name":"testString"`))
+		Expect(atomic.LoadInt32(&secondaryRequests)).To(Equal(int32(2)))
+	})
+	It(`Fail over after repeated 5xx responses and come back after the cool-down`, func() {
+		var healthy int32
+		primaryURL := primaryServer.URL
+		failoverOptions := resourceConfigurationService.NewFailoverOptions([]string{primaryURL, secondaryServer.URL})
+		failoverOptions.SetFailureThreshold(2)
+		failoverOptions.SetCoolDown(10 * time.Millisecond)
+		failoverOptions.SetHealthCheck(func(ctx context.Context, serviceURL string) error {
+			// Health checks run in the background, so they report problems through their result only.
+			if serviceURL != primaryURL || atomic.LoadInt32(&healthy) == 0 {
+				return fmt.Errorf("unhealthy")
+			}
+			return nil
+		})
+		Expect(resourceConfigurationService.EnableFailover(failoverOptions)).To(Succeed())
+
+		atomic.StoreInt32(&primaryStatus, 503)
+		getBackupVaultOptionsModel := resourceConfigurationService.NewGetBackupVaultOptions("testString")
+		for i := 0; i < 2; i++ {
+			_, response, operationErr := resourceConfigurationService.GetBackupVault(getBackupVaultOptionsModel)
+			Expect(operationErr).ToNot(BeNil())
+			Expect(response.StatusCode).To(Equal(503))
+		}
+		Expect(resourceConfigurationService.GetActiveServiceURL()).To(Equal(secondaryServer.URL))
+
+		_, _, operationErr := resourceConfigurationService.GetBackupVault(getBackupVaultOptionsModel)
+		Expect(operationErr).To(BeNil())
+		Expect(atomic.LoadInt32(&primaryRequests)).To(Equal(int32(2)))
+		Expect(atomic.LoadInt32(&secondaryRequests)).To(Equal(int32(1)))
+
+		// The primary stays out of use while its health check fails.
+		time.Sleep(20 * time.Millisecond)
+		_, _, operationErr = resourceConfigurationService.GetBackupVault(getBackupVaultOptionsModel)
+		Expect(operationErr).To(BeNil())
+		Consistently(resourceConfigurationService.GetActiveServiceURL, 30*time.Millisecond).Should(Equal(secondaryServer.URL))
+
+		atomic.StoreInt32(&primaryStatus, 200)
+		atomic.StoreInt32(&healthy, 1)
+		Eventually(func() string {
+			_, _, operationErr := resourceConfigurationService.GetBackupVault(getBackupVaultOptionsModel)
+			Expect(operationErr).To(BeNil())
+			return resourceConfigurationService.GetActiveServiceURL()
+		}).Should(Equal(primaryServer.URL))
+	})
+	It(`Send requests to other URLs unchanged`, func() {
+		failoverOptions := resourceConfigurationService.NewFailoverOptions([]string{primaryServer.URL})
+		Expect(resourceConfigurationService.EnableFailover(failoverOptions)).To(Succeed())
+		Expect(resourceConfigurationService.SetServiceURL(secondaryServer.URL)).To(Succeed())
+		Expect(resourceConfigurationService.GetActiveServiceURL()).To(Equal(secondaryServer.URL))
+
+		_, _, operationErr := resourceConfigurationService.GetBackupVault(resourceConfigurationService.NewGetBackupVaultOptions("testString"))
+		Expect(operationErr).To(BeNil())
+		Expect(atomic.LoadInt32(&secondaryRequests)).To(Equal(int32(1)))
+
+		resourceConfigurationService.DisableFailover()
+		Expect(resourceConfigurationService.Service.GetHTTPClient().Transport).ToNot(BeNil())
+	})
+	It(`Invoke EnableFailover with error`, func() {
+		Expect(resourceConfigurationService.EnableFailover(nil)).ToNot(Succeed())
+		Expect(resourceConfigurationService.EnableFailover(resourceConfigurationService.NewFailoverOptions(nil))).ToNot(Succeed())
+		Expect(resourceConfigurationService.EnableFailover(resourceConfigurationService.NewFailoverOptions([]string{""}))).ToNot(Succeed())
+	})
+})
@@ -0,0 +1,97 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourceconfigurationv1
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// invoke sends "request" for the operation "operationID", the operation ID passed to common.GetSdkHeaders, and
+// unmarshals the response body into "result" like Service.Request. Every operation sends its request through invoke,
+// so that it carries the trace context of the operation and goes through the middleware of the client.
+//
+// The operations in resource_configuration_v1.go are generated, so the template of an XWithContext operation in the
+// generator must emit these hooks, or a regeneration drops them:
+//
+//	ctx, span := resourceConfiguration.startSpan(ctx, "<operationId>", <options>)
+//	defer func() { endSpan(span, response, err) }()
+//
+// first in the method,
+//
+//	err = resourceConfiguration.validateOptions(<options>)
+//	if err != nil {
+//		err = core.SDKErrorf(err, "", "client-validation-error", common.GetComponentInfo())
+//		return
+//	}
+//
+// after core.ValidateStruct, and resourceConfiguration.invoke("<operationId>", request, ...) in place of
+// resourceConfiguration.Service.Request(request, ...). Every options struct needs a Validate method, in validation.go.
+func (resourceConfiguration *ResourceConfigurationV1) invoke(operationID string, request *http.Request, result interface{}) (response *core.DetailedResponse, err error) {
+	resourceConfiguration.traceRequest(request)
+	if len(resourceConfiguration.middleware) > 0 {
+		return resourceConfiguration.invokeMiddleware(operationID, request, result)
+	}
+	return resourceConfiguration.send(operationID, request, result)
+}
+
+// send sends "request" with Service.Request, logged to the logger of the client and measured by its RequestMetrics.
+func (resourceConfiguration *ResourceConfigurationV1) send(operationID string, request *http.Request, result interface{}) (response *core.DetailedResponse, err error) {
+	logger := resourceConfiguration.logger
+	metrics := resourceConfiguration.requestMetrics
+	if logger == nil && metrics == nil {
+		return resourceConfiguration.Service.Request(request, result)
+	}
+
+	var stats *requestStats
+	if metrics != nil {
+		stats = &requestStats{}
+		request = request.WithContext(context.WithValue(request.Context(), requestStatsKey{}, stats))
+	}
+	if logger != nil {
+		resourceConfiguration.logRequest(logger, operationID, request)
+	}
+	start := time.Now()
+	response, err = resourceConfiguration.Service.Request(request, result)
+	duration := time.Since(start)
+	if logger != nil {
+		resourceConfiguration.logResponse(logger, operationID, request, response, duration, err)
+	}
+	if metrics == nil {
+		return
+	}
+
+	observation := &RequestObservation{
+		OperationID:   operationID,
+		Method:        request.Method,
+		Duration:      duration,
+		BytesSent:     stats.bytesSent.Load(),
+		BytesReceived: stats.bytesReceived.Load(),
+		Err:           err,
+	}
+	if attempts := stats.attempts.Load(); attempts > 1 {
+		observation.Retries = int(attempts - 1)
+	}
+	if response != nil {
+		observation.StatusCode = response.StatusCode
+	}
+	metrics.ObserveRequest(request.Context(), observation)
+	return
+}
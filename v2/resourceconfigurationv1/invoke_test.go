@@ -0,0 +1,71 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourceconfigurationv1_test
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// operationCalls returns the names of the functions and methods called by every XWithContext operation of the
+// generated client.
+func operationCalls(filename string) map[string][]string {
+	file, err := parser.ParseFile(token.NewFileSet(), filename, nil, 0)
+	Expect(err).To(BeNil())
+	operations := map[string][]string{}
+	for _, decl := range file.Decls {
+		function, ok := decl.(*ast.FuncDecl)
+		if !ok || function.Recv == nil || !strings.HasSuffix(function.Name.Name, "WithContext") {
+			continue
+		}
+		if star, ok := function.Recv.List[0].Type.(*ast.StarExpr); !ok || star.X.(*ast.Ident).Name != "ResourceConfigurationV1" {
+			continue
+		}
+		calls := []string{}
+		ast.Inspect(function.Body, func(node ast.Node) bool {
+			if call, ok := node.(*ast.CallExpr); ok {
+				switch fun := call.Fun.(type) {
+				case *ast.Ident:
+					calls = append(calls, fun.Name)
+				case *ast.SelectorExpr:
+					calls = append(calls, fun.Sel.Name)
+				}
+			}
+			return true
+		})
+		operations[function.Name.Name] = calls
+	}
+	return operations
+}
+
+var _ = Describe(`ResourceConfigurationV1 generated operations`, func() {
+	It(`Invoke every operation through the hooks of the client`, func() {
+		operations := operationCalls("resource_configuration_v1.go")
+		Expect(operations).To(HaveLen(17))
+		for name, calls := range operations {
+			for _, hook := range []string{"startSpan", "endSpan", "validateOptions", "invoke"} {
+				Expect(calls).To(ContainElement(hook), name)
+			}
+			Expect(calls).ToNot(ContainElement("Request"), name)
+		}
+	})
+})
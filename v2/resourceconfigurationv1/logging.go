@@ -0,0 +1,177 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourceconfigurationv1
+
+import (
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// redactedValue replaces the values that are not logged.
+const redactedValue = "[REDACTED]"
+
+// redactedHeaders are the headers whose values are never logged.
+var redactedHeaders = []string{"Authorization", "Proxy-Authorization"}
+
+// SetLogger makes the client log every operation to "logger"; nil, the default, stops the logging.
+//
+// Each operation is logged when it completes, at the info level, or the error level if it failed, with its operation
+// ID, method, path, status code, duration and request ID. The headers and bodies of the request and the response are
+// logged at the debug level, with Authorization headers and protection management tokens redacted; call
+// SetLogRedactFirewall to redact the IP addresses of bucket firewalls as well.
+func (resourceConfiguration *ResourceConfigurationV1) SetLogger(logger *slog.Logger) {
+	resourceConfiguration.logger = logger
+}
+
+// GetLogger returns the logger the client logs operations to, or nil.
+func (resourceConfiguration *ResourceConfigurationV1) GetLogger() *slog.Logger {
+	return resourceConfiguration.logger
+}
+
+// SetLogRedactFirewall sets whether the "allowed_ip" lists of bucket firewalls are redacted from logged bodies.
+func (resourceConfiguration *ResourceConfigurationV1) SetLogRedactFirewall(redact bool) {
+	resourceConfiguration.logRedactFirewall = redact
+}
+
+// logRequest logs, at the debug level, the headers and body "request" is sent with.
+func (resourceConfiguration *ResourceConfigurationV1) logRequest(logger *slog.Logger, operationID string, request *http.Request) {
+	ctx := request.Context()
+	if !logger.Enabled(ctx, slog.LevelDebug) {
+		return
+	}
+	attributes := []slog.Attr{
+		slog.String("operation", operationID),
+		slog.String("method", request.Method),
+		slog.String("path", request.URL.Path),
+		redactHeaders(request.Header),
+	}
+	if request.GetBody != nil {
+		if body, err := request.GetBody(); err == nil {
+			data, _ := io.ReadAll(body)
+			body.Close()
+			attributes = append(attributes, slog.String("body", resourceConfiguration.redactBody(data)))
+		}
+	}
+	logger.LogAttrs(ctx, slog.LevelDebug, "Sending request", attributes...)
+}
+
+// logResponse logs the outcome of the operation "operationID" and, at the debug level, the headers and body of its
+// response.
+func (resourceConfiguration *ResourceConfigurationV1) logResponse(logger *slog.Logger, operationID string, request *http.Request, response *core.DetailedResponse, duration time.Duration, err error) {
+	ctx := request.Context()
+	attributes := []slog.Attr{
+		slog.String("operation", operationID),
+		slog.String("method", request.Method),
+		slog.String("path", request.URL.Path),
+	}
+	if response != nil {
+		attributes = append(attributes, slog.Int("status", response.StatusCode))
+	}
+	attributes = append(attributes, slog.Duration("duration", duration))
+	if response != nil {
+		if requestID := response.Headers.Get(requestIDHeader); requestID != "" {
+			attributes = append(attributes, slog.String("request_id", requestID))
+		}
+	}
+	if err != nil {
+		attributes = append(attributes, slog.String("error", err.Error()))
+		logger.LogAttrs(ctx, slog.LevelError, "Request failed", attributes...)
+	} else {
+		logger.LogAttrs(ctx, slog.LevelInfo, "Request completed", attributes...)
+	}
+
+	if response == nil || !logger.Enabled(ctx, slog.LevelDebug) {
+		return
+	}
+	attributes = []slog.Attr{
+		slog.String("operation", operationID),
+		slog.Int("status", response.StatusCode),
+		redactHeaders(response.Headers),
+	}
+	var body []byte
+	switch {
+	case response.RawResult != nil:
+		body = response.RawResult
+	case response.Result != nil:
+		body, _ = json.Marshal(response.Result)
+	}
+	if len(body) > 0 {
+		attributes = append(attributes, slog.String("body", resourceConfiguration.redactBody(body)))
+	}
+	logger.LogAttrs(ctx, slog.LevelDebug, "Received response", attributes...)
+}
+
+// redactHeaders returns "header" as a "headers" group, with the values of redactedHeaders replaced.
+func redactHeaders(header http.Header) slog.Attr {
+	header = header.Clone()
+	for _, name := range redactedHeaders {
+		if header.Get(name) != "" {
+			header.Set(name, redactedValue)
+		}
+	}
+	attributes := make([]any, 0, len(header))
+	for name, values := range header {
+		if len(values) == 1 {
+			attributes = append(attributes, slog.String(name, values[0]))
+		} else {
+			attributes = append(attributes, slog.Any(name, values))
+		}
+	}
+	return slog.Group("headers", attributes...)
+}
+
+// redactBody returns the JSON "body" with the protection management tokens, and the firewall IP addresses if the
+// client redacts them, replaced. A body that is not JSON is returned unchanged.
+func (resourceConfiguration *ResourceConfigurationV1) redactBody(body []byte) string {
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err != nil {
+		return string(body)
+	}
+	keys := map[string]bool{"protection_management_token": true}
+	if resourceConfiguration.logRedactFirewall {
+		keys["allowed_ip"] = true
+	}
+	redacted, err := json.Marshal(redactJSON(value, keys))
+	if err != nil {
+		return string(body)
+	}
+	return string(redacted)
+}
+
+// redactJSON replaces the values of the "keys" of the objects in "value".
+func redactJSON(value interface{}, keys map[string]bool) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, element := range v {
+			if keys[key] && element != nil {
+				v[key] = redactedValue
+			} else {
+				v[key] = redactJSON(element, keys)
+			}
+		}
+	case []interface{}:
+		for i, element := range v {
+			v[i] = redactJSON(element, keys)
+		}
+	}
+	return value
+}
@@ -0,0 +1,129 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourceconfigurationv1
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"time"
+)
+
+// RequestMetrics : Receives a measurement of every operation a client sends.
+//
+// The prometheusmetrics and otelmetrics packages report the measurements as Prometheus and OpenTelemetry metrics.
+// ObserveRequest is called synchronously once the response has been read, from the goroutine of the operation.
+type RequestMetrics interface {
+	ObserveRequest(ctx context.Context, observation *RequestObservation)
+}
+
+// RequestObservation : The measurement of one operation.
+type RequestObservation struct {
+	// The ID of the operation, such as "CreateRestore".
+	OperationID string
+
+	// The HTTP method of the request.
+	Method string
+
+	// The HTTP status code of the final response, or 0 if no response was received.
+	StatusCode int
+
+	// The time the operation took, including retries.
+	Duration time.Duration
+
+	// The number of times the request was retried.
+	Retries int
+
+	// The bytes of request body sent, over all attempts.
+	BytesSent int64
+
+	// The bytes of response body received, over all attempts.
+	BytesReceived int64
+
+	// The error the operation returned.
+	Err error
+}
+
+// SetRequestMetrics makes the client report a measurement of every operation to "metrics"; nil stops the reporting.
+//
+// Retries and bytes are counted by a wrapper around the transport of the HTTP client, so call SetRequestMetrics after
+// DisableSSLVerification and SetHTTPClient. Clones made afterwards report to the same metrics.
+func (resourceConfiguration *ResourceConfigurationV1) SetRequestMetrics(metrics RequestMetrics) {
+	resourceConfiguration.requestMetrics = metrics
+	if metrics == nil {
+		return
+	}
+	client := resourceConfiguration.Service.GetHTTPClient()
+	if _, ok := client.Transport.(*metricsTransport); ok {
+		return
+	}
+	next := client.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	client.Transport = &metricsTransport{next: next}
+}
+
+// GetRequestMetrics returns the metrics the client reports to, or nil.
+func (resourceConfiguration *ResourceConfigurationV1) GetRequestMetrics() RequestMetrics {
+	return resourceConfiguration.requestMetrics
+}
+
+// requestStatsKey is the context key of the requestStats of an operation.
+type requestStatsKey struct{}
+
+// requestStats accumulates what the transport sees of the attempts of one operation.
+type requestStats struct {
+	attempts      atomic.Int64
+	bytesSent     atomic.Int64
+	bytesReceived atomic.Int64
+}
+
+// metricsTransport counts the attempts and body bytes of the requests whose context carries requestStats.
+type metricsTransport struct {
+	next http.RoundTripper
+}
+
+func (t *metricsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	stats, ok := req.Context().Value(requestStatsKey{}).(*requestStats)
+	if !ok {
+		return t.next.RoundTrip(req)
+	}
+	stats.attempts.Add(1)
+	if req.Body != nil && req.Body != http.NoBody {
+		req = req.Clone(req.Context())
+		req.Body = &countingReadCloser{ReadCloser: req.Body, count: &stats.bytesSent}
+	}
+	resp, err := t.next.RoundTrip(req)
+	if resp != nil && resp.Body != nil {
+		resp.Body = &countingReadCloser{ReadCloser: resp.Body, count: &stats.bytesReceived}
+	}
+	return resp, err
+}
+
+// countingReadCloser adds the number of bytes read to "count".
+type countingReadCloser struct {
+	io.ReadCloser
+	count *atomic.Int64
+}
+
+func (r *countingReadCloser) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	r.count.Add(int64(n))
+	return n, err
+}
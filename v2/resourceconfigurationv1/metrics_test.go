@@ -0,0 +1,139 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourceconfigurationv1_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/ibm-cos-sdk-go-config/v2/resourceconfigurationv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type recordingMetrics struct {
+	observations []resourceconfigurationv1.RequestObservation
+}
+
+func (metrics *recordingMetrics) ObserveRequest(_ context.Context, observation *resourceconfigurationv1.RequestObservation) {
+	metrics.observations = append(metrics.observations, *observation)
+}
+
+var _ = Describe(`ResourceConfigurationV1 request metrics`, func() {
+	const bucketBody = `{"name": "my-bucket"}`
+	const patchBody = "{\"hard_quota\":1000}\n"
+	var testServer *httptest.Server
+	var resourceConfigurationService *resourceconfigurationv1.ResourceConfigurationV1
+	var metrics *recordingMetrics
+	var unavailable atomic.Int32
+	BeforeEach(func() {
+		unavailable.Store(0)
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			if unavailable.Add(-1) >= 0 {
+				res.Header().Set("Retry-After", "0")
+				res.WriteHeader(503)
+				fmt.Fprint(res, `{"errors": [{"code": "unavailable"}]}`)
+				return
+			}
+			switch path := req.URL.EscapedPath(); path {
+			case "/b/my-bucket":
+				if req.Method == "PATCH" {
+					body, _ := io.ReadAll(req.Body)
+					Expect(string(body)).To(Equal(patchBody))
+					res.WriteHeader(204)
+					return
+				}
+				fmt.Fprint(res, bucketBody)
+			default:
+				res.WriteHeader(404)
+				fmt.Fprint(res, `{"errors": [{"code": "not_found", "message": "bucket not found"}]}`)
+			}
+		}))
+		var serviceErr error
+		resourceConfigurationService, serviceErr = resourceconfigurationv1.NewResourceConfigurationV1(&resourceconfigurationv1.ResourceConfigurationV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		metrics = &recordingMetrics{}
+		resourceConfigurationService.SetRequestMetrics(metrics)
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+	It(`Observe successful operations`, func() {
+		Expect(resourceConfigurationService.GetRequestMetrics()).To(Equal(metrics))
+		_, _, err := resourceConfigurationService.GetBucketConfig(resourceConfigurationService.NewGetBucketConfigOptions("my-bucket"))
+		Expect(err).To(BeNil())
+
+		updateBucketConfigOptionsModel := resourceConfigurationService.NewUpdateBucketConfigOptions("my-bucket")
+		updateBucketConfigOptionsModel.SetBucketPatch(map[string]interface{}{"hard_quota": 1000})
+		_, err = resourceConfigurationService.UpdateBucketConfig(updateBucketConfigOptionsModel)
+		Expect(err).To(BeNil())
+
+		Expect(metrics.observations).To(HaveLen(2))
+		get := metrics.observations[0]
+		Expect(get.OperationID).To(Equal("GetBucketConfig"))
+		Expect(get.Method).To(Equal("GET"))
+		Expect(get.StatusCode).To(Equal(200))
+		Expect(get.Duration).To(BeNumerically(">", 0))
+		Expect(get.Retries).To(Equal(0))
+		Expect(get.BytesSent).To(Equal(int64(0)))
+		Expect(get.BytesReceived).To(Equal(int64(len(bucketBody))))
+		Expect(get.Err).To(BeNil())
+
+		update := metrics.observations[1]
+		Expect(update.OperationID).To(Equal("UpdateBucketConfig"))
+		Expect(update.Method).To(Equal("PATCH"))
+		Expect(update.StatusCode).To(Equal(204))
+		Expect(update.BytesSent).To(Equal(int64(len(patchBody))))
+	})
+	It(`Observe retries and errors`, func() {
+		resourceConfigurationService.EnableRetries(3, 0)
+		unavailable.Store(2)
+		_, _, err := resourceConfigurationService.GetBucketConfig(resourceConfigurationService.NewGetBucketConfigOptions("my-bucket"))
+		Expect(err).To(BeNil())
+		Expect(metrics.observations[0].Retries).To(Equal(2))
+		Expect(metrics.observations[0].StatusCode).To(Equal(200))
+
+		_, _, err = resourceConfigurationService.GetBucketConfig(resourceConfigurationService.NewGetBucketConfigOptions("other-bucket"))
+		Expect(err).ToNot(BeNil())
+		Expect(metrics.observations[1].StatusCode).To(Equal(404))
+		Expect(metrics.observations[1].Err).ToNot(BeNil())
+
+		resourceConfigurationService.SetServiceURL("http://localhost:1")
+		resourceConfigurationService.DisableRetries()
+		_, _, err = resourceConfigurationService.GetBucketConfig(resourceConfigurationService.NewGetBucketConfigOptions("my-bucket"))
+		Expect(err).ToNot(BeNil())
+		Expect(metrics.observations[2].StatusCode).To(Equal(0))
+		Expect(metrics.observations[2].Err).ToNot(BeNil())
+	})
+	It(`Stop observing`, func() {
+		resourceConfigurationService.SetRequestMetrics(nil)
+		_, _, err := resourceConfigurationService.GetBucketConfig(resourceConfigurationService.NewGetBucketConfigOptions("my-bucket"))
+		Expect(err).To(BeNil())
+		Expect(metrics.observations).To(BeEmpty())
+	})
+})
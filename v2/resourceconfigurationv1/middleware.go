@@ -0,0 +1,116 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourceconfigurationv1
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Middleware : Hooks into the requests of every operation a client sends.
+//
+// The BeforeRequest hooks of the middleware of a client are called in the order the middleware was added, after the
+// request is built and before it is logged, measured and sent; the AfterResponse hooks are then called in the reverse
+// order. Both are called from the goroutine of the operation.
+type Middleware interface {
+	// BeforeRequest is called with the request of the operation "operationID", such as "CreateRestore", and may
+	// change its headers. Returning a response or an error ends the operation without sending the request; the
+	// AfterResponse hooks of the middleware added before are still called. The Result of a returned response is
+	// unmarshalled like a JSON response body.
+	BeforeRequest(operationID string, request *http.Request) (*core.DetailedResponse, error)
+
+	// AfterResponse is called with the response and error of the operation "operationID", and returns the response
+	// and error the operation continues with.
+	AfterResponse(operationID string, request *http.Request, response *core.DetailedResponse, err error) (*core.DetailedResponse, error)
+}
+
+// BeforeRequestFunc : A Middleware that calls a function before the request is sent.
+type BeforeRequestFunc func(operationID string, request *http.Request) (*core.DetailedResponse, error)
+
+// BeforeRequest calls the function.
+func (f BeforeRequestFunc) BeforeRequest(operationID string, request *http.Request) (*core.DetailedResponse, error) {
+	return f(operationID, request)
+}
+
+// AfterResponse returns "response" and "err" unchanged.
+func (f BeforeRequestFunc) AfterResponse(_ string, _ *http.Request, response *core.DetailedResponse, err error) (*core.DetailedResponse, error) {
+	return response, err
+}
+
+// AfterResponseFunc : A Middleware that calls a function with the response.
+type AfterResponseFunc func(operationID string, request *http.Request, response *core.DetailedResponse, err error) (*core.DetailedResponse, error)
+
+// BeforeRequest does nothing.
+func (f AfterResponseFunc) BeforeRequest(string, *http.Request) (*core.DetailedResponse, error) {
+	return nil, nil
+}
+
+// AfterResponse calls the function.
+func (f AfterResponseFunc) AfterResponse(operationID string, request *http.Request, response *core.DetailedResponse, err error) (*core.DetailedResponse, error) {
+	return f(operationID, request, response, err)
+}
+
+// AddMiddleware appends "middleware" to the middleware chain of the client. Clones made afterwards share the chain
+// built so far but not middleware added to the original later.
+func (resourceConfiguration *ResourceConfigurationV1) AddMiddleware(middleware ...Middleware) {
+	chain := make([]Middleware, 0, len(resourceConfiguration.middleware)+len(middleware))
+	chain = append(chain, resourceConfiguration.middleware...)
+	resourceConfiguration.middleware = append(chain, middleware...)
+}
+
+// GetMiddleware returns the middleware chain of the client.
+func (resourceConfiguration *ResourceConfigurationV1) GetMiddleware() []Middleware {
+	return resourceConfiguration.middleware
+}
+
+// invokeMiddleware sends "request" through the middleware chain of the client.
+func (resourceConfiguration *ResourceConfigurationV1) invokeMiddleware(operationID string, request *http.Request, result interface{}) (response *core.DetailedResponse, err error) {
+	chain := resourceConfiguration.middleware
+	called := 0
+	for called < len(chain) {
+		response, err = chain[called].BeforeRequest(operationID, request)
+		if response != nil || err != nil {
+			break
+		}
+		called++
+	}
+	if called == len(chain) {
+		response, err = resourceConfiguration.send(operationID, request, result)
+	}
+	for i := called - 1; i >= 0; i-- {
+		response, err = chain[i].AfterResponse(operationID, request, response, err)
+	}
+
+	// A response that was not sent by this operation, e.g. one replayed by a cache, is unmarshalled into "result"
+	// so that the operation returns its result.
+	if err == nil && response != nil && result != nil && response.Result != nil && response.Result != result {
+		var data []byte
+		data, err = json.Marshal(response.Result)
+		if err == nil {
+			err = json.Unmarshal(data, result)
+		}
+		if err != nil {
+			return
+		}
+		replayed := *response
+		replayed.Result = result
+		response = &replayed
+	}
+	return
+}
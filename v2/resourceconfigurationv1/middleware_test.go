@@ -0,0 +1,163 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourceconfigurationv1_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/ibm-cos-sdk-go-config/v2/resourceconfigurationv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// orderMiddleware records the order its hooks are called in.
+type orderMiddleware struct {
+	name  string
+	calls *[]string
+}
+
+func (m orderMiddleware) BeforeRequest(string, *http.Request) (*core.DetailedResponse, error) {
+	*m.calls = append(*m.calls, "before "+m.name)
+	return nil, nil
+}
+
+func (m orderMiddleware) AfterResponse(_ string, _ *http.Request, response *core.DetailedResponse, err error) (*core.DetailedResponse, error) {
+	*m.calls = append(*m.calls, "after "+m.name)
+	return response, err
+}
+
+var _ = Describe(`ResourceConfigurationV1 middleware`, func() {
+	var testServer *httptest.Server
+	var resourceConfigurationService *resourceconfigurationv1.ResourceConfigurationV1
+	var requests []*http.Request
+	BeforeEach(func() {
+		requests = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			requests = append(requests, req)
+			res.Header().Set("Content-type", "application/json")
+			switch path := req.URL.EscapedPath(); path {
+			case "/b/my-bucket":
+				fmt.Fprint(res, `{"name": "my-bucket", "object_count": 12}`)
+			default:
+				res.WriteHeader(404)
+				fmt.Fprint(res, `{"errors": [{"code": "not_found", "message": "bucket not found"}]}`)
+			}
+		}))
+		var serviceErr error
+		resourceConfigurationService, serviceErr = resourceconfigurationv1.NewResourceConfigurationV1(&resourceconfigurationv1.ResourceConfigurationV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Calls the hooks with the operation, request and response`, func() {
+		var audited []string
+		resourceConfigurationService.AddMiddleware(
+			resourceconfigurationv1.BeforeRequestFunc(func(operationID string, request *http.Request) (*core.DetailedResponse, error) {
+				request.Header.Set("X-Audit-Operation", operationID)
+				return nil, nil
+			}),
+			resourceconfigurationv1.AfterResponseFunc(func(operationID string, request *http.Request, response *core.DetailedResponse, err error) (*core.DetailedResponse, error) {
+				audited = append(audited, fmt.Sprintf("%s %s %s %d", operationID, request.Method, request.URL.Path, response.StatusCode))
+				return response, err
+			}),
+		)
+		result, _, err := resourceConfigurationService.GetBucketConfigWithContext(context.Background(), resourceConfigurationService.NewGetBucketConfigOptions("my-bucket"))
+		Expect(err).To(BeNil())
+		Expect(*result.ObjectCount).To(Equal(int64(12)))
+		Expect(requests).To(HaveLen(1))
+		Expect(requests[0].Header.Get("X-Audit-Operation")).To(Equal("GetBucketConfig"))
+		Expect(audited).To(Equal([]string{"GetBucketConfig GET /b/my-bucket 200"}))
+	})
+	It(`Calls the before hooks in order and the after hooks in reverse order`, func() {
+		var calls []string
+		resourceConfigurationService.AddMiddleware(orderMiddleware{"first", &calls})
+		resourceConfigurationService.AddMiddleware(orderMiddleware{"second", &calls})
+		Expect(resourceConfigurationService.GetMiddleware()).To(HaveLen(2))
+
+		_, _, err := resourceConfigurationService.GetBucketConfigWithContext(context.Background(), resourceConfigurationService.NewGetBucketConfigOptions("my-bucket"))
+		Expect(err).To(BeNil())
+		Expect(calls).To(Equal([]string{"before first", "before second", "after second", "after first"}))
+	})
+	It(`Ends the operation with the response of a before hook`, func() {
+		var calls []string
+		cache := map[string]*core.DetailedResponse{}
+		resourceConfigurationService.AddMiddleware(
+			orderMiddleware{"outer", &calls},
+			resourceconfigurationv1.BeforeRequestFunc(func(_ string, request *http.Request) (*core.DetailedResponse, error) {
+				return cache[request.URL.Path], nil
+			}),
+			resourceconfigurationv1.AfterResponseFunc(func(_ string, request *http.Request, response *core.DetailedResponse, err error) (*core.DetailedResponse, error) {
+				if err == nil {
+					cache[request.URL.Path] = response
+				}
+				return response, err
+			}),
+			orderMiddleware{"inner", &calls},
+		)
+		for i := 0; i < 2; i++ {
+			result, response, err := resourceConfigurationService.GetBucketConfigWithContext(context.Background(), resourceConfigurationService.NewGetBucketConfigOptions("my-bucket"))
+			Expect(err).To(BeNil())
+			Expect(response.StatusCode).To(Equal(200))
+			Expect(*result.Name).To(Equal("my-bucket"))
+			Expect(*result.ObjectCount).To(Equal(int64(12)))
+			Expect(response.Result).To(Equal(result))
+		}
+		Expect(requests).To(HaveLen(1))
+		Expect(calls).To(Equal([]string{"before outer", "before inner", "after inner", "after outer", "before outer", "after outer"}))
+	})
+	It(`Returns the errors of hooks`, func() {
+		injected := errors.New("injected failure")
+		resourceConfigurationService.AddMiddleware(resourceconfigurationv1.BeforeRequestFunc(func(string, *http.Request) (*core.DetailedResponse, error) {
+			return nil, injected
+		}))
+		_, _, err := resourceConfigurationService.GetBucketConfigWithContext(context.Background(), resourceConfigurationService.NewGetBucketConfigOptions("my-bucket"))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("injected failure"))
+		Expect(requests).To(BeEmpty())
+	})
+	It(`Lets after hooks replace the error`, func() {
+		resourceConfigurationService.AddMiddleware(resourceconfigurationv1.AfterResponseFunc(func(_ string, _ *http.Request, response *core.DetailedResponse, err error) (*core.DetailedResponse, error) {
+			if response != nil && response.StatusCode == 404 {
+				return response, nil
+			}
+			return response, err
+		}))
+		response, err := resourceConfigurationService.DeleteBackupPolicyWithContext(context.Background(),
+			resourceConfigurationService.NewDeleteBackupPolicyOptions("other-bucket", "p1"))
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(404))
+	})
+	It(`Does not change the middleware of clones`, func() {
+		var calls []string
+		resourceConfigurationService.AddMiddleware(orderMiddleware{"first", &calls})
+		clone := resourceConfigurationService.Clone()
+		resourceConfigurationService.AddMiddleware(orderMiddleware{"second", &calls})
+		Expect(clone.GetMiddleware()).To(HaveLen(1))
+	})
+})
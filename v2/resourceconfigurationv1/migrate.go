@@ -0,0 +1,316 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourceconfigurationv1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/ibm-cos-sdk-go-config/v2/common"
+	"github.com/IBM/ibm-cos-sdk-go-config/v2/crn"
+	"github.com/go-openapi/strfmt"
+)
+
+// Constants associated with the BackupPolicyMigrationStep.Step property.
+// The step of a backup policy migration.
+const (
+	BackupPolicyMigrationStep_Step_Preflight       = "preflight"
+	BackupPolicyMigrationStep_Step_CreatePolicy    = "create_policy"
+	BackupPolicyMigrationStep_Step_WaitPolicy      = "wait_policy"
+	BackupPolicyMigrationStep_Step_DeleteOldPolicy = "delete_old_policy"
+	BackupPolicyMigrationStep_Step_RollBack        = "roll_back"
+)
+
+// MigrateBackupPolicyOptions : The MigrateBackupPolicy options.
+type MigrateBackupPolicyOptions struct {
+	// The name of the new policy. Policy names must be unique per bucket, so it defaults to the name of the old policy
+	// followed by a hyphen and the name of the new backup vault.
+	PolicyName *string `json:"policy_name,omitempty"`
+
+	// The retention of the new policy. Defaults to the retention of the old policy.
+	InitialRetention *DeleteAfterDays `json:"initial_retention,omitempty"`
+
+	// Keep the old policy once the new policy is active.
+	KeepOldPolicy bool `json:"keep_old_policy,omitempty"`
+
+	// The time to wait between checks of the new policy status. Defaults to DefaultWaitPollInterval.
+	PollInterval time.Duration
+
+	// Invoked with the latest state of the new policy after every status check.
+	OnPoll func(backupPolicy *BackupPolicy)
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewMigrateBackupPolicyOptions : Instantiate MigrateBackupPolicyOptions
+func (*ResourceConfigurationV1) NewMigrateBackupPolicyOptions() *MigrateBackupPolicyOptions {
+	return &MigrateBackupPolicyOptions{}
+}
+
+// SetPolicyName : Allow user to set PolicyName
+func (_options *MigrateBackupPolicyOptions) SetPolicyName(policyName string) *MigrateBackupPolicyOptions {
+	_options.PolicyName = core.StringPtr(policyName)
+	return _options
+}
+
+// SetInitialRetention : Allow user to set InitialRetention
+func (_options *MigrateBackupPolicyOptions) SetInitialRetention(initialRetention *DeleteAfterDays) *MigrateBackupPolicyOptions {
+	_options.InitialRetention = initialRetention
+	return _options
+}
+
+// SetKeepOldPolicy : Allow user to set KeepOldPolicy
+func (_options *MigrateBackupPolicyOptions) SetKeepOldPolicy(keepOldPolicy bool) *MigrateBackupPolicyOptions {
+	_options.KeepOldPolicy = keepOldPolicy
+	return _options
+}
+
+// SetPollInterval : Allow user to set PollInterval
+func (_options *MigrateBackupPolicyOptions) SetPollInterval(pollInterval time.Duration) *MigrateBackupPolicyOptions {
+	_options.PollInterval = pollInterval
+	return _options
+}
+
+// SetOnPoll : Allow user to set OnPoll
+func (_options *MigrateBackupPolicyOptions) SetOnPoll(onPoll func(backupPolicy *BackupPolicy)) *MigrateBackupPolicyOptions {
+	_options.OnPoll = onPoll
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *MigrateBackupPolicyOptions) SetHeaders(param map[string]string) *MigrateBackupPolicyOptions {
+	options.Headers = param
+	return options
+}
+
+// BackupPolicyMigration : What a backup policy migration did.
+type BackupPolicyMigration struct {
+	// The bucket whose policy was migrated.
+	Bucket string `json:"bucket"`
+
+	// The policy that was migrated away from.
+	OldPolicy *BackupPolicy `json:"old_policy"`
+
+	// The policy that targets the new backup vault, if it was created.
+	NewPolicy *BackupPolicy `json:"new_policy,omitempty"`
+
+	// The preconditions of creating the new policy.
+	Preflight *PreflightReport `json:"preflight,omitempty"`
+
+	// Whether the old policy was deleted.
+	OldPolicyDeleted bool `json:"old_policy_deleted"`
+
+	// Whether the new policy was deleted again because it did not become active.
+	RolledBack bool `json:"rolled_back"`
+
+	// The steps that were taken, in order.
+	Steps []BackupPolicyMigrationStep `json:"steps"`
+}
+
+// BackupPolicyMigrationStep : One step of a backup policy migration.
+type BackupPolicyMigrationStep struct {
+	// The step.
+	Step string `json:"step"`
+
+	// When the step finished.
+	FinishedAt *strfmt.DateTime `json:"finished_at"`
+
+	// Describes the outcome.
+	Message string `json:"message,omitempty"`
+
+	// The error the step failed with.
+	Error string `json:"error,omitempty"`
+}
+
+func (migration *BackupPolicyMigration) step(step string, err error, format string, args ...interface{}) {
+	migrationStep := BackupPolicyMigrationStep{
+		Step:       step,
+		FinishedAt: currentDateTime(),
+		Message:    fmt.Sprintf(format, args...),
+	}
+	if err != nil {
+		migrationStep.Error = err.Error()
+	}
+	migration.Steps = append(migration.Steps, migrationStep)
+}
+
+// WriteJSON writes the migration to "w" as indented JSON.
+func (migration *BackupPolicyMigration) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(migration)
+}
+
+// WriteTable writes the migration to "w" as aligned columns, one row per step.
+func (migration *BackupPolicyMigration) WriteTable(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 3, ' ', 0)
+	fmt.Fprintln(tw, "STEP\tFINISHED\tMESSAGE\tERROR")
+	for _, step := range migration.Steps {
+		message := step.Message
+		if message == "" {
+			message = "-"
+		}
+		errorMessage := step.Error
+		if errorMessage == "" {
+			errorMessage = "-"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", step.Step, formatDateTime(step.FinishedAt), message, errorMessage)
+	}
+	return tw.Flush()
+}
+
+// MigrateBackupPolicy : Move the backups of a bucket to another backup vault
+// Replaces the backup policy "oldPolicyID" of "bucket" with a policy that targets the backup vault "newVaultCrn":
+//
+//  1. checks the preconditions of creating the new policy with PreflightCreateBackupPolicy; a bucket has at most
+//     3 backup policies, so the bucket must have room for the new policy next to the old one
+//  2. creates the new policy with the backup type and, unless overridden, the retention of the old policy
+//  3. waits until the new policy is `active`
+//  4. deletes the old policy, unless "opts" keeps it.
+//
+// The old policy is never deleted before the new policy is active. If the new policy fails, or the wait is canceled,
+// the new policy is deleted again and the old policy remains in place. The migration is returned with every error
+// that occurs after the old policy has been read, describing the steps that were taken.
+func (resourceConfiguration *ResourceConfigurationV1) MigrateBackupPolicy(ctx context.Context, bucket string, oldPolicyID string, newVaultCrn string, opts *MigrateBackupPolicyOptions) (result *BackupPolicyMigration, err error) {
+	if opts == nil {
+		opts = resourceConfiguration.NewMigrateBackupPolicyOptions()
+	}
+	if bucket == "" {
+		err = core.SDKErrorf(nil, "bucket cannot be empty", "missing-bucket", common.GetComponentInfo())
+		return
+	}
+	if oldPolicyID == "" {
+		err = core.SDKErrorf(nil, "oldPolicyID cannot be empty", "missing-policy-id", common.GetComponentInfo())
+		return
+	}
+	newVault, err := crn.ParseBackupVault(newVaultCrn)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "invalid-backup-vault-crn")
+		return
+	}
+
+	getBackupPolicyOptions := resourceConfiguration.NewGetBackupPolicyOptions(bucket, oldPolicyID)
+	getBackupPolicyOptions.SetHeaders(opts.Headers)
+	oldPolicy, _, err := resourceConfiguration.GetBackupPolicyWithContext(ctx, getBackupPolicyOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "migrate-get-backup-policy-error")
+		return
+	}
+	if sameBackupVault(core.StringNilMapper(oldPolicy.TargetBackupVaultCrn), newVaultCrn) {
+		err = core.SDKErrorf(nil, fmt.Sprintf("backup policy %s already targets backup vault %s", oldPolicyID, newVault.Resource),
+			"backup-policy-already-migrated", common.GetComponentInfo())
+		return
+	}
+	migration := &BackupPolicyMigration{
+		Bucket:    bucket,
+		OldPolicy: oldPolicy,
+		Steps:     []BackupPolicyMigrationStep{},
+	}
+	result = migration
+
+	policyName := core.StringNilMapper(oldPolicy.PolicyName) + "-" + newVault.Resource
+	if opts.PolicyName != nil {
+		policyName = *opts.PolicyName
+	}
+	retention := oldPolicy.InitialRetention
+	if opts.InitialRetention != nil {
+		retention = opts.InitialRetention
+	}
+	backupType := CreateBackupPolicyOptions_BackupType_Continuous
+	if oldPolicy.BackupType != nil {
+		backupType = *oldPolicy.BackupType
+	}
+	createBackupPolicyOptions := resourceConfiguration.NewCreateBackupPolicyOptions(bucket, retention, policyName, newVaultCrn, backupType)
+	createBackupPolicyOptions.SetHeaders(opts.Headers)
+
+	migration.Preflight, err = resourceConfiguration.PreflightCreateBackupPolicyWithContext(ctx, createBackupPolicyOptions)
+	if err != nil {
+		migration.step(BackupPolicyMigrationStep_Step_Preflight, err, "")
+		err = core.RepurposeSDKProblem(err, "migrate-preflight-error")
+		return
+	}
+	if failures := migration.Preflight.Failures(); len(failures) > 0 {
+		messages := []string{}
+		for _, failure := range failures {
+			messages = append(messages, failure.Message)
+		}
+		err = core.SDKErrorf(nil, fmt.Sprintf("cannot create the backup policy %s: %s", policyName, strings.Join(messages, "; ")),
+			"migrate-preflight-failed", common.GetComponentInfo())
+		migration.step(BackupPolicyMigrationStep_Step_Preflight, err, "")
+		return
+	}
+	migration.step(BackupPolicyMigrationStep_Step_Preflight, nil, "backup policy %s can be created", policyName)
+
+	newPolicy, _, err := resourceConfiguration.CreateBackupPolicyWithContext(ctx, createBackupPolicyOptions)
+	if err != nil {
+		migration.step(BackupPolicyMigrationStep_Step_CreatePolicy, err, "")
+		err = core.RepurposeSDKProblem(err, "migrate-create-backup-policy-error")
+		return
+	}
+	migration.NewPolicy = newPolicy
+	migration.step(BackupPolicyMigrationStep_Step_CreatePolicy, nil, "created backup policy %s (%s) targeting backup vault %s",
+		policyName, core.StringNilMapper(newPolicy.PolicyID), newVault.Resource)
+
+	waitForBackupPolicyOptions := resourceConfiguration.NewWaitForBackupPolicyOptions(bucket, core.StringNilMapper(newPolicy.PolicyID))
+	waitForBackupPolicyOptions.SetPollInterval(opts.PollInterval)
+	waitForBackupPolicyOptions.SetOnPoll(opts.OnPoll)
+	waitForBackupPolicyOptions.SetHeaders(opts.Headers)
+	activePolicy, err := resourceConfiguration.WaitForBackupPolicyWithContext(ctx, waitForBackupPolicyOptions)
+	if activePolicy != nil {
+		migration.NewPolicy = activePolicy
+	}
+	if err != nil {
+		migration.step(BackupPolicyMigrationStep_Step_WaitPolicy, err, "")
+		err = core.RepurposeSDKProblem(err, "migrate-wait-backup-policy-error")
+
+		// Roll back even when the failure is a canceled context.
+		deleteBackupPolicyOptions := resourceConfiguration.NewDeleteBackupPolicyOptions(bucket, *waitForBackupPolicyOptions.PolicyID)
+		deleteBackupPolicyOptions.SetHeaders(opts.Headers)
+		if _, deleteErr := resourceConfiguration.DeleteBackupPolicyWithContext(context.WithoutCancel(ctx), deleteBackupPolicyOptions); deleteErr != nil {
+			core.GetLogger().Warn("Unable to delete backup policy %s of bucket %s: %s\n", *waitForBackupPolicyOptions.PolicyID, bucket, deleteErr.Error())
+			migration.step(BackupPolicyMigrationStep_Step_RollBack, deleteErr, "")
+		} else {
+			migration.RolledBack = true
+			migration.step(BackupPolicyMigrationStep_Step_RollBack, nil, "deleted backup policy %s; backup policy %s remains in place",
+				*waitForBackupPolicyOptions.PolicyID, oldPolicyID)
+		}
+		return
+	}
+	migration.step(BackupPolicyMigrationStep_Step_WaitPolicy, nil, "backup policy %s is active", *waitForBackupPolicyOptions.PolicyID)
+
+	if opts.KeepOldPolicy {
+		return
+	}
+	deleteBackupPolicyOptions := resourceConfiguration.NewDeleteBackupPolicyOptions(bucket, oldPolicyID)
+	deleteBackupPolicyOptions.SetHeaders(opts.Headers)
+	_, err = resourceConfiguration.DeleteBackupPolicyWithContext(ctx, deleteBackupPolicyOptions)
+	if err != nil {
+		migration.step(BackupPolicyMigrationStep_Step_DeleteOldPolicy, err, "")
+		err = core.RepurposeSDKProblem(err, "migrate-delete-backup-policy-error")
+		return
+	}
+	migration.OldPolicyDeleted = true
+	migration.step(BackupPolicyMigrationStep_Step_DeleteOldPolicy, nil, "deleted backup policy %s", oldPolicyID)
+	return
+}
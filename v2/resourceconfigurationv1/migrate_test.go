@@ -0,0 +1,172 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourceconfigurationv1_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/ibm-cos-sdk-go-config/v2/resourceconfigurationv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`MigrateBackupPolicy(ctx, bucket, oldPolicyID, newVaultCrn, opts)`, func() {
+	const vaultCrnPrefix = "crn:v1:bluemix:public:cloud-object-storage:global:a/3bf0d9003abfb5d29761c3e97696b71c:d6f04d83-6c4f-4a62-a165-696756d63903:backup-vault:"
+	var testServer *httptest.Server
+	var resourceConfigurationService *resourceconfigurationv1.ResourceConfigurationV1
+	var policies string
+	var statuses []string
+	var created map[string]interface{}
+	var deleted []string
+	BeforeEach(func() {
+		policies = fmt.Sprintf(`[{"policy_id": "old", "policy_name": "daily", "target_backup_vault_crn": "%sold-vault"}]`, vaultCrnPrefix)
+		statuses = []string{"initializing", "active"}
+		created = nil
+		deleted = []string{}
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			switch path := req.URL.EscapedPath(); {
+			case req.Method == "DELETE":
+				deleted = append(deleted, path)
+				res.WriteHeader(204)
+			case req.Method == "POST" && path == "/buckets/my-bucket/backup_policies":
+				Expect(json.NewDecoder(req.Body).Decode(&created)).To(Succeed())
+				res.WriteHeader(201)
+				fmt.Fprintf(res, `{"policy_id": "new", "policy_name": "%s", "policy_status": "pending"}`, created["policy_name"])
+			case path == "/buckets/my-bucket/backup_policies":
+				fmt.Fprintf(res, `{"backup_policies": %s}`, policies)
+			case path == "/buckets/my-bucket/backup_policies/old":
+				fmt.Fprintf(res, `{"policy_id": "old", "policy_name": "daily", "target_backup_vault_crn": "%sold-vault", "backup_type": "continuous",
+					"initial_retention": {"delete_after_days": 30}, "policy_status": "active"}`, vaultCrnPrefix)
+			case path == "/buckets/my-bucket/backup_policies/new":
+				status := statuses[0]
+				if len(statuses) > 1 {
+					statuses = statuses[1:]
+				}
+				fmt.Fprintf(res, `{"policy_id": "new", "policy_status": "%s", "error_cause": "key unavailable"}`, status)
+			case path == "/backup_vaults/new-vault":
+				fmt.Fprint(res, `{"backup_vault_name": "new-vault"}`)
+			default:
+				Fail("unexpected request " + req.Method + " " + path)
+			}
+		}))
+		var serviceErr error
+		resourceConfigurationService, serviceErr = resourceconfigurationv1.NewResourceConfigurationV1(&resourceconfigurationv1.ResourceConfigurationV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+	It(`Invoke MigrateBackupPolicy successfully`, func() {
+		polls := 0
+		migrateBackupPolicyOptionsModel := resourceConfigurationService.NewMigrateBackupPolicyOptions()
+		migrateBackupPolicyOptionsModel.SetPollInterval(time.Millisecond)
+		migrateBackupPolicyOptionsModel.SetOnPoll(func(*resourceconfigurationv1.BackupPolicy) { polls++ })
+		migration, operationErr := resourceConfigurationService.MigrateBackupPolicy(context.Background(), "my-bucket", "old", vaultCrnPrefix+"new-vault", migrateBackupPolicyOptionsModel)
+		Expect(operationErr).To(BeNil())
+		Expect(polls).To(Equal(2))
+		Expect(created["policy_name"]).To(Equal("daily-new-vault"))
+		Expect(created["target_backup_vault_crn"]).To(Equal(vaultCrnPrefix + "new-vault"))
+		Expect(created["initial_retention"]).To(Equal(map[string]interface{}{"delete_after_days": float64(30)}))
+		Expect(*migration.NewPolicy.PolicyStatus).To(Equal("active"))
+		Expect(migration.OldPolicyDeleted).To(BeTrue())
+		Expect(migration.RolledBack).To(BeFalse())
+		Expect(deleted).To(Equal([]string{"/buckets/my-bucket/backup_policies/old"}))
+
+		steps := []string{}
+		for _, step := range migration.Steps {
+			steps = append(steps, step.Step)
+			Expect(step.Error).To(BeEmpty())
+		}
+		Expect(steps).To(Equal([]string{"preflight", "create_policy", "wait_policy", "delete_old_policy"}))
+
+		var table bytes.Buffer
+		Expect(migration.WriteTable(&table)).To(Succeed())
+		lines := strings.Split(strings.TrimSpace(table.String()), "\n")
+		Expect(lines).To(HaveLen(5))
+		Expect(lines[0]).To(MatchRegexp(`^STEP\s+FINISHED\s+MESSAGE\s+ERROR$`))
+		Expect(lines[4]).To(MatchRegexp(`^delete_old_policy\s+\S+\s+deleted backup policy old\s+-$`))
+	})
+	It(`Keep the old policy`, func() {
+		migrateBackupPolicyOptionsModel := resourceConfigurationService.NewMigrateBackupPolicyOptions()
+		migrateBackupPolicyOptionsModel.SetPolicyName("daily-2")
+		migrateBackupPolicyOptionsModel.SetInitialRetention(&resourceconfigurationv1.DeleteAfterDays{DeleteAfterDays: core.Int64Ptr(90)})
+		migrateBackupPolicyOptionsModel.SetKeepOldPolicy(true)
+		migrateBackupPolicyOptionsModel.SetPollInterval(time.Millisecond)
+		migration, operationErr := resourceConfigurationService.MigrateBackupPolicy(context.Background(), "my-bucket", "old", vaultCrnPrefix+"new-vault", migrateBackupPolicyOptionsModel)
+		Expect(operationErr).To(BeNil())
+		Expect(created["policy_name"]).To(Equal("daily-2"))
+		Expect(created["initial_retention"]).To(Equal(map[string]interface{}{"delete_after_days": float64(90)}))
+		Expect(migration.OldPolicyDeleted).To(BeFalse())
+		Expect(deleted).To(BeEmpty())
+	})
+	It(`Roll back when the new policy fails`, func() {
+		statuses = []string{"initializing", "failed"}
+		migrateBackupPolicyOptionsModel := resourceConfigurationService.NewMigrateBackupPolicyOptions()
+		migrateBackupPolicyOptionsModel.SetPollInterval(time.Millisecond)
+		migration, operationErr := resourceConfigurationService.MigrateBackupPolicy(context.Background(), "my-bucket", "old", vaultCrnPrefix+"new-vault", migrateBackupPolicyOptionsModel)
+		Expect(operationErr).ToNot(BeNil())
+		Expect(operationErr.Error()).To(ContainSubstring("key unavailable"))
+		Expect(migration.RolledBack).To(BeTrue())
+		Expect(migration.OldPolicyDeleted).To(BeFalse())
+		Expect(deleted).To(Equal([]string{"/buckets/my-bucket/backup_policies/new"}))
+		Expect(migration.Steps[2].Step).To(Equal("wait_policy"))
+		Expect(migration.Steps[2].Error).To(ContainSubstring("key unavailable"))
+		Expect(migration.Steps[3].Step).To(Equal("roll_back"))
+	})
+	It(`Refuse when the bucket has no room for another policy`, func() {
+		policies = fmt.Sprintf(`[
+			{"policy_id": "old", "policy_name": "daily", "target_backup_vault_crn": "%[1]sold-vault"},
+			{"policy_id": "p2", "policy_name": "weekly", "target_backup_vault_crn": "%[1]svault-2"},
+			{"policy_id": "p3", "policy_name": "monthly", "target_backup_vault_crn": "%[1]svault-3"}
+		]`, vaultCrnPrefix)
+		migration, operationErr := resourceConfigurationService.MigrateBackupPolicy(context.Background(), "my-bucket", "old", vaultCrnPrefix+"new-vault", nil)
+		Expect(operationErr).ToNot(BeNil())
+		Expect(operationErr.Error()).To(ContainSubstring("has 3 of 3 backup policies"))
+		Expect(migration.Preflight.Passed()).To(BeFalse())
+		Expect(migration.NewPolicy).To(BeNil())
+		Expect(created).To(BeNil())
+		Expect(deleted).To(BeEmpty())
+	})
+	It(`Invoke MigrateBackupPolicy with error`, func() {
+		migration, operationErr := resourceConfigurationService.MigrateBackupPolicy(context.Background(), "", "old", vaultCrnPrefix+"new-vault", nil)
+		Expect(operationErr).ToNot(BeNil())
+		Expect(migration).To(BeNil())
+
+		migration, operationErr = resourceConfigurationService.MigrateBackupPolicy(context.Background(), "my-bucket", "old", "new-vault", nil)
+		Expect(operationErr).ToNot(BeNil())
+		Expect(migration).To(BeNil())
+
+		migration, operationErr = resourceConfigurationService.MigrateBackupPolicy(context.Background(), "my-bucket", "old", vaultCrnPrefix+"old-vault", nil)
+		Expect(operationErr).ToNot(BeNil())
+		Expect(operationErr.Error()).To(ContainSubstring("already targets"))
+		Expect(migration).To(BeNil())
+	})
+})
@@ -0,0 +1,448 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourceconfigurationv1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/ibm-cos-sdk-go-config/v2/common"
+	"github.com/go-openapi/strfmt"
+)
+
+// DefaultMonitorInterval is the time between two checks of a Monitor when it is not given an interval.
+const DefaultMonitorInterval = 5 * time.Minute
+
+// Constants associated with the Alert.Kind property.
+// The kind of resource an alert is about.
+const (
+	Alert_Kind_BackupPolicy = "backup_policy"
+	Alert_Kind_Restore      = "restore"
+)
+
+// Constants associated with the Alert.State property.
+// Whether the alert reports a problem or its resolution.
+const (
+	Alert_State_Firing   = "firing"
+	Alert_State_Resolved = "resolved"
+)
+
+// Alert : A backup policy or restore that became unhealthy, or a backup policy that recovered.
+type Alert struct {
+	// The kind of resource the alert is about.
+	Kind string `json:"kind"`
+
+	// Whether the alert reports a problem or its resolution.
+	State string `json:"state"`
+
+	// The bucket of the backup policy.
+	Bucket string `json:"bucket,omitempty"`
+
+	// The backup vault of the restore.
+	BackupVaultName string `json:"backup_vault_name,omitempty"`
+
+	// The policy_id or restore_id of the resource.
+	ResourceID string `json:"resource_id"`
+
+	// The status of the resource.
+	Status string `json:"status"`
+
+	// The status of the resource at the previous check, if it was seen before.
+	PreviousStatus string `json:"previous_status,omitempty"`
+
+	// The error_cause of the resource.
+	ErrorCause string `json:"error_cause,omitempty"`
+
+	// When the monitor detected the change.
+	DetectedAt *strfmt.DateTime `json:"detected_at"`
+}
+
+// String describes the alert in a sentence.
+func (alert *Alert) String() string {
+	var subject string
+	if alert.Kind == Alert_Kind_Restore {
+		subject = fmt.Sprintf("restore %s of backup vault %s", alert.ResourceID, alert.BackupVaultName)
+	} else {
+		subject = fmt.Sprintf("backup policy %s of bucket %s", alert.ResourceID, alert.Bucket)
+	}
+	if alert.State == Alert_State_Resolved {
+		return fmt.Sprintf("%s is %s again", subject, alert.Status)
+	}
+	message := fmt.Sprintf("%s is %s", subject, alert.Status)
+	if alert.PreviousStatus != "" {
+		message += fmt.Sprintf(" (was %s)", alert.PreviousStatus)
+	}
+	if alert.ErrorCause != "" {
+		message += ": " + alert.ErrorCause
+	}
+	return message
+}
+
+// AlertSink : Receives the alerts of a Monitor.
+type AlertSink interface {
+	Send(ctx context.Context, alert *Alert) error
+}
+
+// AlertSinkFunc : An AlertSink that calls a function.
+type AlertSinkFunc func(ctx context.Context, alert *Alert) error
+
+// Send calls the function.
+func (f AlertSinkFunc) Send(ctx context.Context, alert *Alert) error {
+	return f(ctx, alert)
+}
+
+// LogAlertSink : An AlertSink that logs alerts; firing alerts at the Warn level and resolutions at the Info level.
+type LogAlertSink struct {
+	// The logger. Defaults to the logger of the Go core.
+	Logger core.Logger
+}
+
+// NewLogAlertSink : Instantiate LogAlertSink
+func NewLogAlertSink(logger core.Logger) *LogAlertSink {
+	return &LogAlertSink{Logger: logger}
+}
+
+// Send logs the alert.
+func (sink *LogAlertSink) Send(_ context.Context, alert *Alert) error {
+	logger := sink.Logger
+	if logger == nil {
+		logger = core.GetLogger()
+	}
+	if alert.State == Alert_State_Resolved {
+		logger.Info("Resolved: %s\n", alert.String())
+	} else {
+		logger.Warn("Alert: %s\n", alert.String())
+	}
+	return nil
+}
+
+// WebhookAlertSink : An AlertSink that posts every alert as a JSON document to a URL.
+type WebhookAlertSink struct {
+	// The URL to post alerts to.
+	URL string
+
+	// Headers to add to every request, such as an Authorization header.
+	Headers map[string]string
+
+	// The client to post with. Defaults to a client with a 30 second timeout.
+	Client *http.Client
+}
+
+// NewWebhookAlertSink : Instantiate WebhookAlertSink
+func NewWebhookAlertSink(url string) *WebhookAlertSink {
+	return &WebhookAlertSink{URL: url}
+}
+
+// Send posts the alert. A response status outside of 2xx is an error.
+func (sink *WebhookAlertSink) Send(ctx context.Context, alert *Alert) error {
+	body, err := json.Marshal(alert)
+	if err != nil {
+		return core.SDKErrorf(err, "", "webhook-marshal-error", common.GetComponentInfo())
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, sink.URL, bytes.NewReader(body))
+	if err != nil {
+		return core.SDKErrorf(err, "", "webhook-request-error", common.GetComponentInfo())
+	}
+	request.Header.Set("Content-Type", "application/json")
+	for name, value := range sink.Headers {
+		request.Header.Set(name, value)
+	}
+	client := sink.Client
+	if client == nil {
+		client = &http.Client{Timeout: 30 * time.Second}
+	}
+	response, err := client.Do(request)
+	if err != nil {
+		return core.SDKErrorf(err, "", "webhook-send-error", common.GetComponentInfo())
+	}
+	defer response.Body.Close()
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return core.SDKErrorf(nil, fmt.Sprintf("webhook %s responded with status %d", sink.URL, response.StatusCode),
+			"webhook-status-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// MonitorOptions : The NewMonitor options.
+type MonitorOptions struct {
+	// The buckets whose backup policies are watched.
+	Buckets []string `json:"buckets,omitempty" validate:"dive,required"`
+
+	// The backup vaults whose restores are watched.
+	BackupVaults []string `json:"backup_vaults,omitempty" validate:"dive,required"`
+
+	// The sinks every alert is sent to.
+	Sinks []AlertSink `validate:"required,min=1"`
+
+	// The time between two checks. Defaults to DefaultMonitorInterval.
+	Interval time.Duration
+
+	// Invoked with the errors of a check, such as a bucket that could not be listed or a sink that failed. Defaults to
+	// logging the error at the Warn level.
+	OnError func(err error)
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewMonitorOptions : Instantiate MonitorOptions
+func (*ResourceConfigurationV1) NewMonitorOptions(sinks ...AlertSink) *MonitorOptions {
+	return &MonitorOptions{
+		Sinks: sinks,
+	}
+}
+
+// SetBuckets : Allow user to set Buckets
+func (_options *MonitorOptions) SetBuckets(buckets []string) *MonitorOptions {
+	_options.Buckets = buckets
+	return _options
+}
+
+// SetBackupVaults : Allow user to set BackupVaults
+func (_options *MonitorOptions) SetBackupVaults(backupVaults []string) *MonitorOptions {
+	_options.BackupVaults = backupVaults
+	return _options
+}
+
+// SetInterval : Allow user to set Interval
+func (_options *MonitorOptions) SetInterval(interval time.Duration) *MonitorOptions {
+	_options.Interval = interval
+	return _options
+}
+
+// SetOnError : Allow user to set OnError
+func (_options *MonitorOptions) SetOnError(onError func(err error)) *MonitorOptions {
+	_options.OnError = onError
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *MonitorOptions) SetHeaders(param map[string]string) *MonitorOptions {
+	options.Headers = param
+	return options
+}
+
+// Monitor : Watches backup policies and restores and alerts when they become unhealthy.
+//
+// A backup policy raises an alert when it enters the `action_needed`, `degraded` or `failed` status, and a restore
+// when it enters the `failed` status, including when the monitor first sees it in that status. An alert is raised once
+// per status: a resource that stays unhealthy raises no further alerts until its status changes. A backup policy that
+// returns to `active` after an alert raises a resolved alert.
+type Monitor struct {
+	service *ResourceConfigurationV1
+	options MonitorOptions
+
+	// Serializes checks; the maps below belong to the check in progress.
+	mutex sync.Mutex
+
+	// The status of every resource at the previous check, by resource key.
+	statuses map[string]string
+
+	// The resources whose last alert was a firing alert, by resource key.
+	firing map[string]bool
+}
+
+// NewMonitor : Create a Monitor
+// The monitor does nothing until Run or Check is called.
+func (resourceConfiguration *ResourceConfigurationV1) NewMonitor(monitorOptions *MonitorOptions) (monitor *Monitor, err error) {
+	err = core.ValidateNotNil(monitorOptions, "monitorOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(monitorOptions, "monitorOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	if len(monitorOptions.Buckets) == 0 && len(monitorOptions.BackupVaults) == 0 {
+		err = core.SDKErrorf(nil, "at least one bucket or backup vault must be monitored", "monitor-nothing-to-watch", common.GetComponentInfo())
+		return
+	}
+	monitor = &Monitor{
+		service:  resourceConfiguration,
+		options:  *monitorOptions,
+		statuses: map[string]string{},
+		firing:   map[string]bool{},
+	}
+	if monitor.options.Interval <= 0 {
+		monitor.options.Interval = DefaultMonitorInterval
+	}
+	return
+}
+
+// Run checks the backup policies and restores immediately and then every interval, until "ctx" is done. It returns
+// nil once "ctx" is done; errors of individual checks are passed to OnError.
+func (monitor *Monitor) Run(ctx context.Context) error {
+	ticker := time.NewTicker(monitor.options.Interval)
+	defer ticker.Stop()
+	for {
+		monitor.Check(ctx)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Check lists the backup policies and restores once, sends the alerts their changes raise and returns the alerts.
+// Resources that cannot be listed keep their previous status.
+func (monitor *Monitor) Check(ctx context.Context) []Alert {
+	monitor.mutex.Lock()
+	defer monitor.mutex.Unlock()
+
+	alerts := []Alert{}
+	seen := map[string]bool{}
+	for _, bucket := range monitor.options.Buckets {
+		listBackupPoliciesOptions := monitor.service.NewListBackupPoliciesOptions(bucket)
+		listBackupPoliciesOptions.SetHeaders(monitor.options.Headers)
+		policies, _, err := monitor.service.ListBackupPoliciesWithContext(ctx, listBackupPoliciesOptions)
+		if err != nil {
+			monitor.keep(fmt.Sprintf("%s/%s/", Alert_Kind_BackupPolicy, bucket), seen)
+			monitor.reportError(core.RepurposeSDKProblem(err, "monitor-list-backup-policies-error"))
+			continue
+		}
+		for _, policy := range policies.BackupPolicies {
+			alert := &Alert{
+				Kind:       Alert_Kind_BackupPolicy,
+				Bucket:     bucket,
+				ResourceID: core.StringNilMapper(policy.PolicyID),
+				Status:     core.StringNilMapper(policy.PolicyStatus),
+				ErrorCause: core.StringNilMapper(policy.ErrorCause),
+			}
+			if monitor.observe(alert, seen) {
+				alerts = append(alerts, *alert)
+			}
+		}
+	}
+	for _, vault := range monitor.options.BackupVaults {
+		listRestoresOptions := monitor.service.NewListRestoresOptions(vault)
+		listRestoresOptions.SetHeaders(monitor.options.Headers)
+		restores, err := monitor.listRestores(ctx, listRestoresOptions)
+		if err != nil {
+			monitor.keep(fmt.Sprintf("%s/%s/", Alert_Kind_Restore, vault), seen)
+			monitor.reportError(core.RepurposeSDKProblem(err, "monitor-list-restores-error"))
+			continue
+		}
+		for _, restore := range restores {
+			alert := &Alert{
+				Kind:            Alert_Kind_Restore,
+				BackupVaultName: vault,
+				ResourceID:      core.StringNilMapper(restore.RestoreID),
+				Status:          core.StringNilMapper(restore.RestoreStatus),
+				ErrorCause:      core.StringNilMapper(restore.ErrorCause),
+			}
+			if monitor.observe(alert, seen) {
+				alerts = append(alerts, *alert)
+			}
+		}
+	}
+
+	// Forget resources that no longer exist.
+	for key := range monitor.statuses {
+		if !seen[key] {
+			delete(monitor.statuses, key)
+			delete(monitor.firing, key)
+		}
+	}
+
+	for i := range alerts {
+		for _, sink := range monitor.options.Sinks {
+			if err := sink.Send(ctx, &alerts[i]); err != nil {
+				monitor.reportError(core.RepurposeSDKProblem(err, "monitor-alert-sink-error"))
+			}
+		}
+	}
+	return alerts
+}
+
+func (monitor *Monitor) listRestores(ctx context.Context, listRestoresOptions *ListRestoresOptions) ([]Restore, error) {
+	pager, err := monitor.service.NewRestoresPager(listRestoresOptions)
+	if err != nil {
+		return nil, err
+	}
+	return pager.GetAllWithContext(ctx)
+}
+
+// observe records the status of the resource described by "alert" and completes the alert if the status change
+// raises one.
+func (monitor *Monitor) observe(alert *Alert, seen map[string]bool) bool {
+	owner := alert.Bucket
+	if alert.Kind == Alert_Kind_Restore {
+		owner = alert.BackupVaultName
+	}
+	key := fmt.Sprintf("%s/%s/%s", alert.Kind, owner, alert.ResourceID)
+	seen[key] = true
+	previous, known := monitor.statuses[key]
+	monitor.statuses[key] = alert.Status
+	if known {
+		alert.PreviousStatus = previous
+	}
+
+	switch {
+	case isUnhealthyStatus(alert.Kind, alert.Status):
+		if monitor.firing[key] && previous == alert.Status {
+			return false
+		}
+		monitor.firing[key] = true
+		alert.State = Alert_State_Firing
+	case monitor.firing[key] && alert.Kind == Alert_Kind_BackupPolicy && alert.Status == BackupPolicy_PolicyStatus_Active:
+		delete(monitor.firing, key)
+		alert.State = Alert_State_Resolved
+		alert.ErrorCause = ""
+	default:
+		return false
+	}
+	alert.DetectedAt = currentDateTime()
+	return true
+}
+
+// keep marks every known resource whose key starts with "prefix" as seen.
+func (monitor *Monitor) keep(prefix string, seen map[string]bool) {
+	for key := range monitor.statuses {
+		if strings.HasPrefix(key, prefix) {
+			seen[key] = true
+		}
+	}
+}
+
+func (monitor *Monitor) reportError(err error) {
+	if monitor.options.OnError != nil {
+		monitor.options.OnError(err)
+		return
+	}
+	core.GetLogger().Warn("Backup health monitor: %s\n", err.Error())
+}
+
+func isUnhealthyStatus(kind string, status string) bool {
+	if kind == Alert_Kind_Restore {
+		return status == Restore_RestoreStatus_Failed
+	}
+	switch status {
+	case BackupPolicy_PolicyStatus_ActionNeeded, BackupPolicy_PolicyStatus_Degraded, BackupPolicy_PolicyStatus_Failed:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,205 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourceconfigurationv1_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/ibm-cos-sdk-go-config/v2/resourceconfigurationv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ResourceConfigurationV1 backup health monitor`, func() {
+	var testServer *httptest.Server
+	var resourceConfigurationService *resourceconfigurationv1.ResourceConfigurationV1
+	var mutex sync.Mutex
+	var policyStatus, restoreStatus string
+	var policiesUnavailable bool
+	var received []resourceconfigurationv1.Alert
+	var sink resourceconfigurationv1.AlertSink
+	setStatus := func(policy string, restore string) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		policyStatus, restoreStatus = policy, restore
+	}
+	BeforeEach(func() {
+		setStatus("active", "running")
+		policiesUnavailable = false
+		received = nil
+		sink = resourceconfigurationv1.AlertSinkFunc(func(_ context.Context, alert *resourceconfigurationv1.Alert) error {
+			mutex.Lock()
+			defer mutex.Unlock()
+			received = append(received, *alert)
+			return nil
+		})
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			Expect(req.Method).To(Equal("GET"))
+			res.Header().Set("Content-type", "application/json")
+			switch path := req.URL.EscapedPath(); path {
+			case "/buckets/my-bucket/backup_policies":
+				if policiesUnavailable {
+					res.WriteHeader(503)
+					fmt.Fprint(res, `{"errors": [{"code": "unavailable", "message": "try again later"}]}`)
+					return
+				}
+				fmt.Fprintf(res, `{"backup_policies": [{"policy_id": "p1", "policy_status": "%s", "error_cause": "key deleted"}, {"policy_id": "p2", "policy_status": "active"}]}`, policyStatus)
+			case "/backup_vaults/my-vault/restores":
+				fmt.Fprintf(res, `{"restores": [{"restore_id": "r1", "restore_status": "%s"}]}`, restoreStatus)
+			default:
+				Fail("unexpected request " + path)
+			}
+		}))
+		var serviceErr error
+		resourceConfigurationService, serviceErr = resourceconfigurationv1.NewResourceConfigurationV1(&resourceconfigurationv1.ResourceConfigurationV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+	It(`Raise, deduplicate and resolve alerts`, func() {
+		errs := []error{}
+		monitorOptionsModel := resourceConfigurationService.NewMonitorOptions(sink)
+		monitorOptionsModel.SetBuckets([]string{"my-bucket"})
+		monitorOptionsModel.SetBackupVaults([]string{"my-vault"})
+		monitorOptionsModel.SetOnError(func(err error) { errs = append(errs, err) })
+		monitor, err := resourceConfigurationService.NewMonitor(monitorOptionsModel)
+		Expect(err).To(BeNil())
+
+		Expect(monitor.Check(context.Background())).To(BeEmpty())
+
+		setStatus("degraded", "running")
+		alerts := monitor.Check(context.Background())
+		Expect(alerts).To(HaveLen(1))
+		Expect(alerts[0].Kind).To(Equal("backup_policy"))
+		Expect(alerts[0].State).To(Equal("firing"))
+		Expect(alerts[0].Status).To(Equal("degraded"))
+		Expect(alerts[0].PreviousStatus).To(Equal("active"))
+		Expect(alerts[0].DetectedAt).ToNot(BeNil())
+		Expect(alerts[0].String()).To(Equal("backup policy p1 of bucket my-bucket is degraded (was active): key deleted"))
+		Expect(received).To(Equal(alerts))
+
+		Expect(monitor.Check(context.Background())).To(BeEmpty())
+
+		setStatus("failed", "failed")
+		alerts = monitor.Check(context.Background())
+		Expect(alerts).To(HaveLen(2))
+		Expect(alerts[0].Status).To(Equal("failed"))
+		Expect(alerts[1].Kind).To(Equal("restore"))
+		Expect(alerts[1].String()).To(Equal("restore r1 of backup vault my-vault is failed (was running)"))
+
+		policiesUnavailable = true
+		Expect(monitor.Check(context.Background())).To(BeEmpty())
+		Expect(errs).To(HaveLen(1))
+		Expect(errs[0].Error()).To(ContainSubstring("try again later"))
+
+		policiesUnavailable = false
+		setStatus("active", "failed")
+		alerts = monitor.Check(context.Background())
+		Expect(alerts).To(HaveLen(1))
+		Expect(alerts[0].State).To(Equal("resolved"))
+		Expect(alerts[0].ErrorCause).To(BeEmpty())
+		Expect(alerts[0].String()).To(Equal("backup policy p1 of bucket my-bucket is active again"))
+		Expect(received).To(HaveLen(4))
+
+		Expect(monitor.Check(context.Background())).To(BeEmpty())
+	})
+	It(`Alert on resources that are unhealthy when first seen`, func() {
+		setStatus("action_needed", "failed")
+		monitor, err := resourceConfigurationService.NewMonitor(resourceConfigurationService.NewMonitorOptions(sink).SetBuckets([]string{"my-bucket"}).SetBackupVaults([]string{"my-vault"}))
+		Expect(err).To(BeNil())
+		alerts := monitor.Check(context.Background())
+		Expect(alerts).To(HaveLen(2))
+		Expect(alerts[0].PreviousStatus).To(BeEmpty())
+		Expect(alerts[0].String()).To(Equal("backup policy p1 of bucket my-bucket is action_needed: key deleted"))
+	})
+	It(`Post alerts to a webhook`, func() {
+		var posted []map[string]interface{}
+		webhook := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			Expect(req.Method).To(Equal("POST"))
+			Expect(req.Header.Get("Content-Type")).To(Equal("application/json"))
+			Expect(req.Header.Get("Authorization")).To(Equal("Bearer secret"))
+			var body map[string]interface{}
+			Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+			posted = append(posted, body)
+			if len(posted) > 1 {
+				res.WriteHeader(500)
+			}
+		}))
+		defer webhook.Close()
+
+		webhookSink := resourceconfigurationv1.NewWebhookAlertSink(webhook.URL)
+		webhookSink.Headers = map[string]string{"Authorization": "Bearer secret"}
+		alert := &resourceconfigurationv1.Alert{Kind: "restore", State: "firing", BackupVaultName: "my-vault", ResourceID: "r1", Status: "failed"}
+		Expect(webhookSink.Send(context.Background(), alert)).To(Succeed())
+		Expect(posted[0]["resource_id"]).To(Equal("r1"))
+		Expect(posted[0]["backup_vault_name"]).To(Equal("my-vault"))
+
+		err := webhookSink.Send(context.Background(), alert)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("responded with status 500"))
+	})
+	It(`Run until canceled`, func() {
+		monitorOptionsModel := resourceConfigurationService.NewMonitorOptions(sink, resourceconfigurationv1.NewLogAlertSink(nil))
+		monitorOptionsModel.SetBackupVaults([]string{"my-vault"})
+		monitorOptionsModel.SetInterval(5 * time.Millisecond)
+		monitor, err := resourceConfigurationService.NewMonitor(monitorOptionsModel)
+		Expect(err).To(BeNil())
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error)
+		go func() { done <- monitor.Run(ctx) }()
+		time.Sleep(20 * time.Millisecond)
+		setStatus("active", "failed")
+		Eventually(func() int {
+			mutex.Lock()
+			defer mutex.Unlock()
+			return len(received)
+		}).Should(Equal(1))
+		cancel()
+		Eventually(done).Should(Receive(BeNil()))
+	})
+	It(`Invoke NewMonitor with error`, func() {
+		monitor, err := resourceConfigurationService.NewMonitor(nil)
+		Expect(err).ToNot(BeNil())
+		Expect(monitor).To(BeNil())
+
+		monitor, err = resourceConfigurationService.NewMonitor(resourceConfigurationService.NewMonitorOptions().SetBuckets([]string{"my-bucket"}))
+		Expect(err).ToNot(BeNil())
+		Expect(monitor).To(BeNil())
+
+		monitor, err = resourceConfigurationService.NewMonitor(resourceConfigurationService.NewMonitorOptions(sink))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("at least one bucket or backup vault"))
+		Expect(monitor).To(BeNil())
+	})
+})
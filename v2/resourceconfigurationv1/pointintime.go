@@ -0,0 +1,208 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourceconfigurationv1
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/ibm-cos-sdk-go-config/v2/common"
+	"github.com/go-openapi/strfmt"
+)
+
+// ResolvePointInTimeOptions : The ResolvePointInTime options.
+type ResolvePointInTimeOptions struct {
+	// Name of the service_instance whose BackupVaults are searched.
+	ServiceInstanceID *string `json:"service_instance_id" validate:"required,ne="`
+
+	// The CRN of the bucket to restore.
+	SourceResourceCrn *string `json:"source_resource_crn" validate:"required,ne="`
+
+	// The time to restore the bucket to.
+	PointInTime *strfmt.DateTime `json:"point_in_time" validate:"required"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewResolvePointInTimeOptions : Instantiate ResolvePointInTimeOptions
+func (*ResourceConfigurationV1) NewResolvePointInTimeOptions(serviceInstanceID string, sourceResourceCrn string, pointInTime *strfmt.DateTime) *ResolvePointInTimeOptions {
+	return &ResolvePointInTimeOptions{
+		ServiceInstanceID: core.StringPtr(serviceInstanceID),
+		SourceResourceCrn: core.StringPtr(sourceResourceCrn),
+		PointInTime:       pointInTime,
+	}
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *ResolvePointInTimeOptions) SetHeaders(param map[string]string) *ResolvePointInTimeOptions {
+	options.Headers = param
+	return options
+}
+
+// PointInTimeCandidate : A RecoveryRange that contains the requested point in time.
+type PointInTimeCandidate struct {
+	// The name of the BackupVault that holds the RecoveryRange.
+	BackupVaultName string `json:"backup_vault_name"`
+
+	// The RecoveryRange.
+	RecoveryRange *RecoveryRange `json:"recovery_range"`
+}
+
+// NewCreateRestoreOptions returns the CreateRestore options that restore the candidate's range at "pointInTime" to
+// the bucket with the CRN "targetResourceCrn", as an in-place restore.
+func (candidate *PointInTimeCandidate) NewCreateRestoreOptions(pointInTime *strfmt.DateTime, targetResourceCrn string) *CreateRestoreOptions {
+	return &CreateRestoreOptions{
+		BackupVaultName:    core.StringPtr(candidate.BackupVaultName),
+		RecoveryRangeID:    candidate.RecoveryRange.RecoveryRangeID,
+		RestoreType:        core.StringPtr(CreateRestoreOptions_RestoreType_InPlace),
+		RestorePointInTime: pointInTime,
+		TargetResourceCrn:  core.StringPtr(targetResourceCrn),
+	}
+}
+
+// ResolvePointInTime : Find the RecoveryRanges that can restore a bucket to a point in time
+// Searches every BackupVault of the service instance for RecoveryRanges of the source bucket whose
+// [range_start_time, range_end_time] contains the point in time.
+//
+// The candidates are ranked by preference: ranges that end later first, since they are still being extended or were
+// extended longest and so are furthest from expiring, then ranges with longer retention, with indefinite retention
+// longest. Ties are broken by BackupVault name and RecoveryRange ID so that the order is stable. The result is empty
+// when no range contains the point in time.
+func (resourceConfiguration *ResourceConfigurationV1) ResolvePointInTime(resolvePointInTimeOptions *ResolvePointInTimeOptions) (result []PointInTimeCandidate, err error) {
+	result, err = resourceConfiguration.ResolvePointInTimeWithContext(context.Background(), resolvePointInTimeOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ResolvePointInTimeWithContext is an alternate form of the ResolvePointInTime method which supports a Context parameter
+func (resourceConfiguration *ResourceConfigurationV1) ResolvePointInTimeWithContext(ctx context.Context, resolvePointInTimeOptions *ResolvePointInTimeOptions) (result []PointInTimeCandidate, err error) {
+	err = core.ValidateNotNil(resolvePointInTimeOptions, "resolvePointInTimeOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(resolvePointInTimeOptions, "resolvePointInTimeOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	ranges, err := resourceConfiguration.listSourceRecoveryRanges(ctx, *resolvePointInTimeOptions.ServiceInstanceID,
+		*resolvePointInTimeOptions.SourceResourceCrn, resolvePointInTimeOptions.Headers)
+	if err != nil {
+		return
+	}
+	result = selectCandidates(ranges, time.Time(*resolvePointInTimeOptions.PointInTime))
+	return
+}
+
+// listSourceRecoveryRanges returns every RecoveryRange of the bucket with the CRN "sourceResourceCrn" in the
+// BackupVaults of the service instance, as candidates for any point in time.
+func (resourceConfiguration *ResourceConfigurationV1) listSourceRecoveryRanges(ctx context.Context, serviceInstanceID string, sourceResourceCrn string, headers map[string]string) (result []PointInTimeCandidate, err error) {
+	listBackupVaultsOptions := resourceConfiguration.NewListBackupVaultsOptions(serviceInstanceID)
+	listBackupVaultsOptions.SetHeaders(headers)
+	vaultsPager, err := resourceConfiguration.NewBackupVaultsPager(listBackupVaultsOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "resolve-list-backup-vaults-error")
+		return
+	}
+	vaults, err := vaultsPager.GetAllWithContext(ctx)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "resolve-list-backup-vaults-error")
+		return
+	}
+
+	result = []PointInTimeCandidate{}
+	for _, vault := range vaults {
+		listRecoveryRangesOptions := resourceConfiguration.NewListRecoveryRangesOptions(vault)
+		listRecoveryRangesOptions.SetSourceResourceCrn(sourceResourceCrn)
+		listRecoveryRangesOptions.SetHeaders(headers)
+		var rangesPager *RecoveryRangesPager
+		rangesPager, err = resourceConfiguration.NewRecoveryRangesPager(listRecoveryRangesOptions)
+		if err != nil {
+			result = nil
+			err = core.RepurposeSDKProblem(err, "resolve-list-recovery-ranges-error")
+			return
+		}
+		var ranges []RecoveryRange
+		ranges, err = rangesPager.GetAllWithContext(ctx)
+		if err != nil {
+			result = nil
+			err = core.RepurposeSDKProblem(err, "resolve-list-recovery-ranges-error")
+			return
+		}
+		for i := range ranges {
+			result = append(result, PointInTimeCandidate{
+				BackupVaultName: vault,
+				RecoveryRange:   &ranges[i],
+			})
+		}
+	}
+	return
+}
+
+// selectCandidates returns the candidates of "ranges" whose RecoveryRange contains "pointInTime", ranked by preference.
+func selectCandidates(ranges []PointInTimeCandidate, pointInTime time.Time) []PointInTimeCandidate {
+	result := []PointInTimeCandidate{}
+	for _, candidate := range ranges {
+		if rangeContains(candidate.RecoveryRange, pointInTime) {
+			result = append(result, candidate)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return preferCandidate(&result[i], &result[j])
+	})
+	return result
+}
+
+// rangeContains reports whether "t" is within the bounds of "recoveryRange", inclusive.
+func rangeContains(recoveryRange *RecoveryRange, t time.Time) bool {
+	if recoveryRange.RangeStartTime == nil || recoveryRange.RangeEndTime == nil {
+		return false
+	}
+	return !t.Before(time.Time(*recoveryRange.RangeStartTime)) && !t.After(time.Time(*recoveryRange.RangeEndTime))
+}
+
+// preferCandidate reports whether "a" ranks before "b".
+func preferCandidate(a *PointInTimeCandidate, b *PointInTimeCandidate) bool {
+	endA, endB := time.Time(*a.RecoveryRange.RangeEndTime), time.Time(*b.RecoveryRange.RangeEndTime)
+	if !endA.Equal(endB) {
+		return endA.After(endB)
+	}
+	if retentionA, retentionB := retentionRank(a.RecoveryRange), retentionRank(b.RecoveryRange); retentionA != retentionB {
+		return retentionA > retentionB
+	}
+	if a.BackupVaultName != b.BackupVaultName {
+		return a.BackupVaultName < b.BackupVaultName
+	}
+	return core.StringNilMapper(a.RecoveryRange.RecoveryRangeID) < core.StringNilMapper(b.RecoveryRange.RecoveryRangeID)
+}
+
+// retentionRank orders retentions from shortest to longest; indefinite retention ranks highest.
+func retentionRank(recoveryRange *RecoveryRange) int64 {
+	if recoveryRange.Retention == nil || recoveryRange.Retention.DeleteAfterDays == nil {
+		return 0
+	}
+	if *recoveryRange.Retention.DeleteAfterDays == IndefiniteRetentionDays {
+		return math.MaxInt64
+	}
+	return *recoveryRange.Retention.DeleteAfterDays
+}
@@ -0,0 +1,120 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourceconfigurationv1_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/ibm-cos-sdk-go-config/v2/resourceconfigurationv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ResourceConfigurationV1 point-in-time resolver`, func() {
+	const bucketCRN = "crn:v1:bluemix:public:cloud-object-storage:global:a/3bf0d9003abfb5d29761c3e97696b71c:d6f04d83-6c4f-4a62-a165-696756d63903:bucket:my-bucket"
+	var testServer *httptest.Server
+	var resourceConfigurationService *resourceconfigurationv1.ResourceConfigurationV1
+	BeforeEach(func() {
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.Method).To(Equal("GET"))
+			res.Header().Set("Content-type", "application/json")
+			switch req.URL.EscapedPath() {
+			case "/backup_vaults":
+				Expect(req.URL.Query().Get("service_instance_id")).To(Equal("testInstance"))
+				if req.URL.Query().Get("token") == "" {
+					fmt.Fprint(res, `{"next": {"href": "next", "token": "page2"}, "backup_vaults": ["vault-b", "vault-a"]}`)
+				} else {
+					fmt.Fprint(res, `{"backup_vaults": ["vault-c", "vault-empty"]}`)
+				}
+			case "/backup_vaults/vault-a/recovery_ranges":
+				Expect(req.URL.Query().Get("source_resource_crn")).To(Equal(bucketCRN))
+				fmt.Fprint(res, `{"recovery_ranges": [
+					{"recovery_range_id": "a-old", "range_start_time": "2025-01-01T00:00:00.000Z", "range_end_time": "2025-03-01T00:00:00.000Z", "retention": {"delete_after_days": 30}},
+					{"recovery_range_id": "a-before", "range_start_time": "2024-01-01T00:00:00.000Z", "range_end_time": "2024-12-01T00:00:00.000Z"},
+					{"recovery_range_id": "a-open", "range_start_time": "2025-01-01T00:00:00.000Z"}
+				]}`)
+			case "/backup_vaults/vault-b/recovery_ranges":
+				fmt.Fprint(res, `{"recovery_ranges": [
+					{"recovery_range_id": "b-current", "range_start_time": "2025-01-15T00:00:00.000Z", "range_end_time": "2025-06-01T00:00:00.000Z", "retention": {"delete_after_days": 30}}
+				]}`)
+			case "/backup_vaults/vault-c/recovery_ranges":
+				fmt.Fprint(res, `{"recovery_ranges": [
+					{"recovery_range_id": "c-indefinite", "range_start_time": "2025-02-01T00:00:00.000Z", "range_end_time": "2025-03-01T00:00:00.000Z", "retention": {"delete_after_days": -1}},
+					{"recovery_range_id": "c-edge", "range_start_time": "2025-02-01T00:00:00.000Z", "range_end_time": "2025-02-01T00:00:00.000Z"}
+				]}`)
+			case "/backup_vaults/vault-empty/recovery_ranges":
+				fmt.Fprint(res, `{"recovery_ranges": []}`)
+			default:
+				Fail("unexpected request " + req.URL.Path)
+			}
+		}))
+		var serviceErr error
+		resourceConfigurationService, serviceErr = resourceconfigurationv1.NewResourceConfigurationV1(&resourceconfigurationv1.ResourceConfigurationV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+	It(`Invoke ResolvePointInTime successfully`, func() {
+		pointInTime := CreateMockDateTime("2025-02-01T00:00:00.000Z")
+		resolvePointInTimeOptionsModel := resourceConfigurationService.NewResolvePointInTimeOptions("testInstance", bucketCRN, pointInTime)
+		result, operationErr := resourceConfigurationService.ResolvePointInTime(resolvePointInTimeOptionsModel)
+		Expect(operationErr).To(BeNil())
+
+		ranked := []string{}
+		for _, candidate := range result {
+			ranked = append(ranked, candidate.BackupVaultName+"/"+*candidate.RecoveryRange.RecoveryRangeID)
+		}
+		Expect(ranked).To(Equal([]string{"vault-b/b-current", "vault-c/c-indefinite", "vault-a/a-old", "vault-c/c-edge"}))
+
+		createRestoreOptionsModel := result[0].NewCreateRestoreOptions(pointInTime, bucketCRN)
+		Expect(*createRestoreOptionsModel.BackupVaultName).To(Equal("vault-b"))
+		Expect(*createRestoreOptionsModel.RecoveryRangeID).To(Equal("b-current"))
+		Expect(*createRestoreOptionsModel.RestoreType).To(Equal("in_place"))
+		Expect(createRestoreOptionsModel.RestorePointInTime).To(Equal(pointInTime))
+		Expect(*createRestoreOptionsModel.TargetResourceCrn).To(Equal(bucketCRN))
+	})
+	It(`Invoke ResolvePointInTime with no candidates`, func() {
+		resolvePointInTimeOptionsModel := resourceConfigurationService.NewResolvePointInTimeOptions("testInstance", bucketCRN, CreateMockDateTime("2020-01-01T00:00:00.000Z"))
+		result, operationErr := resourceConfigurationService.ResolvePointInTime(resolvePointInTimeOptionsModel)
+		Expect(operationErr).To(BeNil())
+		Expect(result).ToNot(BeNil())
+		Expect(result).To(BeEmpty())
+	})
+	It(`Invoke ResolvePointInTime with error`, func() {
+		result, operationErr := resourceConfigurationService.ResolvePointInTime(nil)
+		Expect(operationErr).ToNot(BeNil())
+		Expect(result).To(BeNil())
+
+		result, operationErr = resourceConfigurationService.ResolvePointInTime(resourceConfigurationService.NewResolvePointInTimeOptions("testInstance", bucketCRN, nil))
+		Expect(operationErr).ToNot(BeNil())
+		Expect(result).To(BeNil())
+
+		resourceConfigurationService.SetServiceURL("http://localhost:1")
+		result, operationErr = resourceConfigurationService.ResolvePointInTime(resourceConfigurationService.NewResolvePointInTimeOptions("testInstance", bucketCRN, CreateMockDateTime("2025-02-01T00:00:00.000Z")))
+		Expect(operationErr).ToNot(BeNil())
+		Expect(result).To(BeNil())
+	})
+})
@@ -0,0 +1,177 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourceconfigurationv1
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/ibm-cos-sdk-go-config/v2/common"
+	"github.com/IBM/ibm-cos-sdk-go-config/v2/crn"
+)
+
+// MaxBackupPoliciesPerBucket is the number of backup policies a bucket can have.
+const MaxBackupPoliciesPerBucket = 3
+
+// Constants associated with the PreflightCheck.Name property.
+// The CreateBackupPolicy precondition that was checked.
+const (
+	PreflightCheck_Name_BackupVaultExists      = "backup_vault_exists"
+	PreflightCheck_Name_NoPolicyForBackupVault = "no_policy_for_backup_vault"
+	PreflightCheck_Name_UniquePolicyName       = "unique_policy_name"
+	PreflightCheck_Name_BackupPolicyLimit      = "backup_policy_limit"
+)
+
+// PreflightCheck : The outcome of one precondition.
+type PreflightCheck struct {
+	// The precondition that was checked.
+	Name string `json:"name"`
+
+	// Whether the precondition holds.
+	Passed bool `json:"passed"`
+
+	// Describes the outcome.
+	Message string `json:"message"`
+}
+
+// PreflightReport : The outcome of every precondition of an operation.
+type PreflightReport struct {
+	// The checked preconditions, in the order they were checked.
+	Checks []PreflightCheck `json:"checks"`
+}
+
+// Passed reports whether every precondition holds.
+func (report *PreflightReport) Passed() bool {
+	return len(report.Failures()) == 0
+}
+
+// Failures returns the preconditions that do not hold.
+func (report *PreflightReport) Failures() (failures []PreflightCheck) {
+	for _, check := range report.Checks {
+		if !check.Passed {
+			failures = append(failures, check)
+		}
+	}
+	return
+}
+
+func (report *PreflightReport) add(name string, passed bool, format string, args ...interface{}) {
+	report.Checks = append(report.Checks, PreflightCheck{
+		Name:    name,
+		Passed:  passed,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// PreflightCreateBackupPolicy : Check the preconditions of CreateBackupPolicy
+// Checks, with GetBackupVault and ListBackupPolicies, the preconditions of CreateBackupPolicy that do not depend on
+// permissions or bucket versioning:
+//
+//   - the Backup Vault must exist
+//   - the source-bucket must not have an existing BackupPolicy targeting the Backup Vault
+//   - the source-bucket must not have a BackupPolicy with the same policy_name
+//   - the source-bucket must have fewer than 3 total BackupPolicies.
+//
+// Failed preconditions are reported in the result, not as an error; an error is returned only when the checks could
+// not be made.
+func (resourceConfiguration *ResourceConfigurationV1) PreflightCreateBackupPolicy(createBackupPolicyOptions *CreateBackupPolicyOptions) (result *PreflightReport, err error) {
+	result, err = resourceConfiguration.PreflightCreateBackupPolicyWithContext(context.Background(), createBackupPolicyOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// PreflightCreateBackupPolicyWithContext is an alternate form of the PreflightCreateBackupPolicy method which supports a Context parameter
+func (resourceConfiguration *ResourceConfigurationV1) PreflightCreateBackupPolicyWithContext(ctx context.Context, createBackupPolicyOptions *CreateBackupPolicyOptions) (result *PreflightReport, err error) {
+	err = core.ValidateNotNil(createBackupPolicyOptions, "createBackupPolicyOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(createBackupPolicyOptions, "createBackupPolicyOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	result = &PreflightReport{}
+	targetCrn := *createBackupPolicyOptions.TargetBackupVaultCrn
+	vault, parseErr := crn.ParseBackupVault(targetCrn)
+	if parseErr != nil {
+		result.add(PreflightCheck_Name_BackupVaultExists, false, "%s", parseErr.Error())
+	} else {
+		getBackupVaultOptions := resourceConfiguration.NewGetBackupVaultOptions(vault.Resource)
+		getBackupVaultOptions.SetHeaders(createBackupPolicyOptions.Headers)
+		_, response, getErr := resourceConfiguration.GetBackupVaultWithContext(ctx, getBackupVaultOptions)
+		switch {
+		case getErr == nil:
+			result.add(PreflightCheck_Name_BackupVaultExists, true, "backup vault %s exists", vault.Resource)
+		case response != nil && response.StatusCode == http.StatusNotFound:
+			result.add(PreflightCheck_Name_BackupVaultExists, false, "backup vault %s does not exist", vault.Resource)
+		default:
+			result = nil
+			err = core.RepurposeSDKProblem(getErr, "preflight-get-backup-vault-error")
+			return
+		}
+	}
+
+	listBackupPoliciesOptions := resourceConfiguration.NewListBackupPoliciesOptions(*createBackupPolicyOptions.Bucket)
+	listBackupPoliciesOptions.SetHeaders(createBackupPolicyOptions.Headers)
+	policies, _, err := resourceConfiguration.ListBackupPoliciesWithContext(ctx, listBackupPoliciesOptions)
+	if err != nil {
+		result = nil
+		err = core.RepurposeSDKProblem(err, "preflight-list-backup-policies-error")
+		return
+	}
+
+	var sameVault, sameName *BackupPolicy
+	for i := range policies.BackupPolicies {
+		policy := &policies.BackupPolicies[i]
+		if sameVault == nil && sameBackupVault(core.StringNilMapper(policy.TargetBackupVaultCrn), targetCrn) {
+			sameVault = policy
+		}
+		if sameName == nil && core.StringNilMapper(policy.PolicyName) == *createBackupPolicyOptions.PolicyName {
+			sameName = policy
+		}
+	}
+	if sameVault != nil {
+		result.add(PreflightCheck_Name_NoPolicyForBackupVault, false, "backup policy %s already targets the backup vault", core.StringNilMapper(sameVault.PolicyID))
+	} else {
+		result.add(PreflightCheck_Name_NoPolicyForBackupVault, true, "no backup policy targets the backup vault")
+	}
+	if sameName != nil {
+		result.add(PreflightCheck_Name_UniquePolicyName, false, "backup policy %s is already named %s", core.StringNilMapper(sameName.PolicyID), *createBackupPolicyOptions.PolicyName)
+	} else {
+		result.add(PreflightCheck_Name_UniquePolicyName, true, "no backup policy is named %s", *createBackupPolicyOptions.PolicyName)
+	}
+	count := len(policies.BackupPolicies)
+	result.add(PreflightCheck_Name_BackupPolicyLimit, count < MaxBackupPoliciesPerBucket, "bucket %s has %d of %d backup policies",
+		*createBackupPolicyOptions.Bucket, count, MaxBackupPoliciesPerBucket)
+	return
+}
+
+// sameBackupVault reports whether two backup vault CRNs identify the same vault. Backup vault names are global, so
+// CRNs that differ only in formatting still match.
+func sameBackupVault(a string, b string) bool {
+	if a == b {
+		return true
+	}
+	vaultA, errA := crn.ParseBackupVault(a)
+	vaultB, errB := crn.ParseBackupVault(b)
+	return errA == nil && errB == nil && vaultA.Resource == vaultB.Resource
+}
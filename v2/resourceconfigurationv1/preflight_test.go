@@ -0,0 +1,128 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourceconfigurationv1_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/ibm-cos-sdk-go-config/v2/resourceconfigurationv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ResourceConfigurationV1 preflight checks`, func() {
+	const crnPrefix = "crn:v1:bluemix:public:cloud-object-storage:global:a/3bf0d9003abfb5d29761c3e97696b71c:d6f04d83-6c4f-4a62-a165-696756d63903:backup-vault:"
+	var testServer *httptest.Server
+	var vaultStatus int
+	var policiesBody string
+	var resourceConfigurationService *resourceconfigurationv1.ResourceConfigurationV1
+	BeforeEach(func() {
+		vaultStatus = 200
+		policiesBody = `{"backup_policies": []}`
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.Method).To(Equal("GET"))
+			Expect(req.Header.Get("X-Test")).To(Equal("preflight"))
+			res.Header().Set("Content-type", "application/json")
+			switch req.URL.EscapedPath() {
+			case "/backup_vaults/my-vault":
+				res.WriteHeader(vaultStatus)
+				fmt.Fprint(res, `{"backup_vault_name": "my-vault"}`)
+			case "/buckets/my-bucket/backup_policies":
+				res.WriteHeader(200)
+				fmt.Fprint(res, policiesBody)
+			default:
+				Fail("unexpected request " + req.URL.Path)
+			}
+		}))
+		var serviceErr error
+		resourceConfigurationService, serviceErr = resourceconfigurationv1.NewResourceConfigurationV1(&resourceconfigurationv1.ResourceConfigurationV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+	newOptions := func(vaultCRN string) *resourceconfigurationv1.CreateBackupPolicyOptions {
+		deleteAfterDaysModel := &resourceconfigurationv1.DeleteAfterDays{
+			DeleteAfterDays: core.Int64Ptr(int64(30)),
+		}
+		options := resourceConfigurationService.NewCreateBackupPolicyOptions("my-bucket", deleteAfterDaysModel, "daily", vaultCRN, "continuous")
+		options.SetHeaders(map[string]string{"X-Test": "preflight"})
+		return options
+	}
+	It(`Invoke PreflightCreateBackupPolicy successfully`, func() {
+		result, operationErr := resourceConfigurationService.PreflightCreateBackupPolicy(newOptions(crnPrefix + "my-vault"))
+		Expect(operationErr).To(BeNil())
+		Expect(result.Passed()).To(BeTrue())
+		Expect(result.Failures()).To(BeEmpty())
+		names := []string{}
+		for _, check := range result.Checks {
+			names = append(names, check.Name)
+		}
+		Expect(names).To(Equal([]string{
+			resourceconfigurationv1.PreflightCheck_Name_BackupVaultExists,
+			resourceconfigurationv1.PreflightCheck_Name_NoPolicyForBackupVault,
+			resourceconfigurationv1.PreflightCheck_Name_UniquePolicyName,
+			resourceconfigurationv1.PreflightCheck_Name_BackupPolicyLimit,
+		}))
+	})
+	It(`Invoke PreflightCreateBackupPolicy with failed preconditions`, func() {
+		vaultStatus = 404
+		policiesBody = fmt.Sprintf(`{"backup_policies": [
+			{"policy_id": "p1", "policy_name": "daily", "target_backup_vault_crn": "%[1]sother-vault", "backup_type": "continuous", "initial_retention": {"delete_after_days": 1}, "policy_status": "active"},
+			{"policy_id": "p2", "policy_name": "weekly", "target_backup_vault_crn": "%[1]smy-vault", "backup_type": "continuous", "initial_retention": {"delete_after_days": 1}, "policy_status": "active"},
+			{"policy_id": "p3", "policy_name": "monthly", "target_backup_vault_crn": "%[1]sthird-vault", "backup_type": "continuous", "initial_retention": {"delete_after_days": 1}, "policy_status": "active"}
+		]}`, crnPrefix)
+		result, operationErr := resourceConfigurationService.PreflightCreateBackupPolicy(newOptions(crnPrefix + "my-vault"))
+		Expect(operationErr).To(BeNil())
+		Expect(result.Passed()).To(BeFalse())
+		Expect(result.Failures()).To(Equal([]resourceconfigurationv1.PreflightCheck{
+			{Name: resourceconfigurationv1.PreflightCheck_Name_BackupVaultExists, Message: "backup vault my-vault does not exist"},
+			{Name: resourceconfigurationv1.PreflightCheck_Name_NoPolicyForBackupVault, Message: "backup policy p2 already targets the backup vault"},
+			{Name: resourceconfigurationv1.PreflightCheck_Name_UniquePolicyName, Message: "backup policy p1 is already named daily"},
+			{Name: resourceconfigurationv1.PreflightCheck_Name_BackupPolicyLimit, Message: "bucket my-bucket has 3 of 3 backup policies"},
+		}))
+	})
+	It(`Invoke PreflightCreateBackupPolicy with an invalid backup vault CRN`, func() {
+		result, operationErr := resourceConfigurationService.PreflightCreateBackupPolicy(newOptions("testString"))
+		Expect(operationErr).To(BeNil())
+		Expect(result.Failures()).To(HaveLen(1))
+		Expect(result.Failures()[0].Name).To(Equal(resourceconfigurationv1.PreflightCheck_Name_BackupVaultExists))
+		Expect(result.Failures()[0].Message).To(ContainSubstring("is not a CRN"))
+	})
+	It(`Invoke PreflightCreateBackupPolicy with error`, func() {
+		vaultStatus = 403
+		result, operationErr := resourceConfigurationService.PreflightCreateBackupPolicy(newOptions(crnPrefix + "my-vault"))
+		Expect(operationErr).ToNot(BeNil())
+		Expect(result).To(BeNil())
+
+		result, operationErr = resourceConfigurationService.PreflightCreateBackupPolicy(nil)
+		Expect(operationErr).ToNot(BeNil())
+		Expect(result).To(BeNil())
+
+		result, operationErr = resourceConfigurationService.PreflightCreateBackupPolicy(&resourceconfigurationv1.CreateBackupPolicyOptions{})
+		Expect(operationErr).ToNot(BeNil())
+		Expect(result).To(BeNil())
+	})
+})
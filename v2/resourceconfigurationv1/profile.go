@@ -0,0 +1,301 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourceconfigurationv1
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/ibm-cos-sdk-go-config/v2/common"
+	"sigs.k8s.io/yaml"
+)
+
+// ProfileConfigFileEnv is the environment variable that overrides the location of the profile file.
+const ProfileConfigFileEnv = "COSCONFIG_CONFIG_FILE"
+
+// ProfileEnv is the environment variable that selects the profile when no name is given.
+const ProfileEnv = "COSCONFIG_PROFILE"
+
+// DefaultProfileName is the profile used when no name is given and the file does not set "default_profile".
+const DefaultProfileName = "default"
+
+// ProfileConfig : The contents of a profile file, by default "~/.cosconfig/config.yaml".
+//
+// For example:
+//
+//	default_profile: dev
+//	profiles:
+//	  dev:
+//	    apikey_env: DEV_API_KEY
+//	    service_instance_id: d6f76k03-6k4f-4a82-n165-697654o63903
+//	  prod:
+//	    apikey_file: /run/secrets/cos-apikey
+//	    endpoint_type: private
+//	    service_instance_id: 4e3f1c0f-0b6e-4f33-9d2a-6c2f7a51a1b8
+type ProfileConfig struct {
+	// The profile used when no name is given. Defaults to "default".
+	DefaultProfile string `json:"default_profile,omitempty"`
+
+	// The named profiles.
+	Profiles map[string]Profile `json:"profiles"`
+
+	// Whether the file can be read by users other than its owner.
+	shared bool
+
+	// The path the file was read from.
+	path string
+}
+
+// Profile : The credentials and endpoint of one account.
+//
+// Exactly one of APIKey, APIKeyEnv and APIKeyFile must be set. Storing the API key inline is only allowed
+// when the profile file is readable by its owner alone.
+type Profile struct {
+	// The IAM API key.
+	APIKey string `json:"apikey,omitempty"`
+
+	// The name of an environment variable holding the IAM API key.
+	APIKeyEnv string `json:"apikey_env,omitempty"`
+
+	// The path of a file holding the IAM API key. A leading "~/" refers to the home directory.
+	APIKeyFile string `json:"apikey_file,omitempty"`
+
+	// The IAM token service URL. Defaults to the public IAM endpoint.
+	IAMURL string `json:"iam_url,omitempty"`
+
+	// The Resource Configuration service URL. Cannot be combined with Region or EndpointType.
+	URL string `json:"url,omitempty"`
+
+	// The region whose endpoint is used, or "global" (the default).
+	Region string `json:"region,omitempty"`
+
+	// The network used to reach the service: "public" (the default), "private" or "direct".
+	EndpointType string `json:"endpoint_type,omitempty"`
+
+	// The service instance used by operations that take one, such as ListBackupVaults.
+	ServiceInstanceID string `json:"service_instance_id,omitempty"`
+}
+
+// DefaultProfileConfigPath returns the location of the profile file: $COSCONFIG_CONFIG_FILE if set,
+// otherwise "~/.cosconfig/config.yaml".
+func DefaultProfileConfigPath() (string, error) {
+	if path := os.Getenv(ProfileConfigFileEnv); path != "" {
+		return path, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		err = core.SDKErrorf(err, "", "profile-home-error", common.GetComponentInfo())
+		return "", err
+	}
+	return filepath.Join(home, ".cosconfig", "config.yaml"), nil
+}
+
+// LoadProfileConfig reads the profile file at "path". The profiles are not validated: GetProfile validates the
+// profile it returns, so that a broken profile does not block the others, and Validate checks the whole file.
+func LoadProfileConfig(path string) (*ProfileConfig, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "profile-file-error", common.GetComponentInfo())
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "profile-file-error", common.GetComponentInfo())
+		return nil, err
+	}
+
+	config := &ProfileConfig{}
+	if err = yaml.UnmarshalStrict(data, config); err != nil {
+		err = core.SDKErrorf(err, fmt.Sprintf("invalid profile file %s: %s", path, err.Error()), "profile-parse-error", common.GetComponentInfo())
+		return nil, err
+	}
+	config.shared = runtime.GOOS != "windows" && info.Mode().Perm()&0o077 != 0
+	config.path = path
+	return config, nil
+}
+
+// Validate checks every profile of the file, and returns an error for the first invalid one.
+func (config *ProfileConfig) Validate() error {
+	for _, name := range config.names() {
+		if err := config.validateProfile(name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetProfile validates and returns the profile called "name". An empty name selects $COSCONFIG_PROFILE, then
+// the file's "default_profile", then "default".
+func (config *ProfileConfig) GetProfile(name string) (*Profile, error) {
+	if name == "" {
+		name = os.Getenv(ProfileEnv)
+	}
+	if name == "" {
+		name = config.DefaultProfile
+	}
+	if name == "" {
+		name = DefaultProfileName
+	}
+
+	profile, ok := config.Profiles[name]
+	if !ok {
+		err := core.SDKErrorf(nil, fmt.Sprintf("profile %q not found (available profiles: %s)", name, strings.Join(config.names(), ", ")), "profile-not-found", common.GetComponentInfo())
+		return nil, err
+	}
+	if err := config.validateProfile(name); err != nil {
+		return nil, err
+	}
+	return &profile, nil
+}
+
+func (config *ProfileConfig) names() []string {
+	names := make([]string, 0, len(config.Profiles))
+	for name := range config.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (config *ProfileConfig) validateProfile(name string) error {
+	if err := config.checkProfile(name); err != nil {
+		return core.SDKErrorf(err, fmt.Sprintf("invalid profile %q in %s: %s", name, config.path, err.Error()), "profile-validation-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+func (config *ProfileConfig) checkProfile(name string) error {
+	profile := config.Profiles[name]
+
+	sources := 0
+	for _, source := range []string{profile.APIKey, profile.APIKeyEnv, profile.APIKeyFile} {
+		if source != "" {
+			sources++
+		}
+	}
+	if sources != 1 {
+		return fmt.Errorf("exactly one of apikey, apikey_env and apikey_file must be set")
+	}
+	if profile.APIKey != "" && config.shared {
+		return fmt.Errorf("the file contains an inline apikey but is readable by other users; restrict it with \"chmod 600\" or use apikey_env or apikey_file")
+	}
+	if profile.URL != "" && (profile.Region != "" || profile.EndpointType != "") {
+		return fmt.Errorf("url cannot be combined with region or endpoint_type")
+	}
+	if _, err := GetServiceURLForEndpoint(profile.Region, profile.EndpointType); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetAPIKey returns the API key of the profile from its configured source.
+func (profile *Profile) GetAPIKey() (string, error) {
+	var apiKey string
+	switch {
+	case profile.APIKey != "":
+		apiKey = profile.APIKey
+	case profile.APIKeyEnv != "":
+		apiKey = os.Getenv(profile.APIKeyEnv)
+		if apiKey == "" {
+			err := core.SDKErrorf(nil, fmt.Sprintf("environment variable %s is not set", profile.APIKeyEnv), "profile-apikey-error", common.GetComponentInfo())
+			return "", err
+		}
+	case profile.APIKeyFile != "":
+		path := profile.APIKeyFile
+		if strings.HasPrefix(path, "~/") {
+			home, err := os.UserHomeDir()
+			if err != nil {
+				err = core.SDKErrorf(err, "", "profile-home-error", common.GetComponentInfo())
+				return "", err
+			}
+			path = filepath.Join(home, path[2:])
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			err = core.SDKErrorf(err, "", "profile-apikey-error", common.GetComponentInfo())
+			return "", err
+		}
+		apiKey = strings.TrimSpace(string(data))
+		if apiKey == "" {
+			err = core.SDKErrorf(nil, fmt.Sprintf("API key file %s is empty", path), "profile-apikey-error", common.GetComponentInfo())
+			return "", err
+		}
+	default:
+		err := core.SDKErrorf(nil, "profile has no API key source", "profile-apikey-error", common.GetComponentInfo())
+		return "", err
+	}
+	return apiKey, nil
+}
+
+// NewFromProfile : constructs an instance of ResourceConfigurationV1 from the profile called "name" in
+// the profile file (see DefaultProfileConfigPath). An empty name selects the default profile.
+func NewFromProfile(name string) (resourceConfiguration *ResourceConfigurationV1, err error) {
+	path, err := DefaultProfileConfigPath()
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "profile-path-error")
+		return
+	}
+	config, err := LoadProfileConfig(path)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "profile-load-error")
+		return
+	}
+	profile, err := config.GetProfile(name)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "profile-get-error")
+		return
+	}
+	resourceConfiguration, err = NewResourceConfigurationV1UsingProfile(profile)
+	err = core.RepurposeSDKProblem(err, "new-client-error")
+	return
+}
+
+// NewResourceConfigurationV1UsingProfile : constructs an instance of ResourceConfigurationV1 with the
+// credentials and endpoint of "profile".
+func NewResourceConfigurationV1UsingProfile(profile *Profile) (resourceConfiguration *ResourceConfigurationV1, err error) {
+	apiKey, err := profile.GetAPIKey()
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "profile-apikey-error")
+		return
+	}
+	authenticator, err := core.NewIamAuthenticatorBuilder().
+		SetApiKey(apiKey).
+		SetURL(profile.IAMURL).
+		Build()
+	if err != nil {
+		err = core.SDKErrorf(err, "", "profile-auth-error", common.GetComponentInfo())
+		return
+	}
+
+	resourceConfiguration, err = NewResourceConfigurationV1(&ResourceConfigurationV1Options{
+		URL:           profile.URL,
+		Region:        profile.Region,
+		EndpointType:  profile.EndpointType,
+		Authenticator: authenticator,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "new-client-error")
+		return
+	}
+	resourceConfiguration.ServiceInstanceID = profile.ServiceInstanceID
+	return
+}
@@ -0,0 +1,186 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourceconfigurationv1_test
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/ibm-cos-sdk-go-config/v2/resourceconfigurationv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ResourceConfigurationV1 profiles`, func() {
+	var dir string
+	var configPath string
+	writeConfig := func(contents string, mode os.FileMode) {
+		Expect(os.WriteFile(configPath, []byte(contents), mode)).To(Succeed())
+		Expect(os.Chmod(configPath, mode)).To(Succeed())
+	}
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "cosconfig")
+		Expect(err).To(BeNil())
+		configPath = filepath.Join(dir, "config.yaml")
+		os.Setenv(resourceconfigurationv1.ProfileConfigFileEnv, configPath)
+		os.Unsetenv(resourceconfigurationv1.ProfileEnv)
+	})
+	AfterEach(func() {
+		os.Unsetenv(resourceconfigurationv1.ProfileConfigFileEnv)
+		os.Unsetenv(resourceconfigurationv1.ProfileEnv)
+		os.Unsetenv("TEST_PROFILE_API_KEY")
+		os.RemoveAll(dir)
+	})
+	Describe(`NewFromProfile(name string)`, func() {
+		BeforeEach(func() {
+			Expect(os.WriteFile(filepath.Join(dir, "apikey"), []byte("file-api-key\n"), 0600)).To(Succeed())
+			writeConfig(`
+default_profile: dev
+profiles:
+  dev:
+    apikey_env: TEST_PROFILE_API_KEY
+    service_instance_id: dev-instance
+  prod:
+    apikey_file: `+filepath.Join(dir, "apikey")+`
+    region: us-south
+    endpoint_type: private
+    iam_url: https://private.iam.cloud.ibm.com
+    service_instance_id: prod-instance
+  local:
+    apikey: inline-api-key
+    url: http://localhost:8080/v1
+`, 0600)
+			os.Setenv("TEST_PROFILE_API_KEY", "env-api-key")
+		})
+		It(`Invoke NewFromProfile with the default profile`, func() {
+			resourceConfigurationService, err := resourceconfigurationv1.NewFromProfile("")
+			Expect(err).To(BeNil())
+			Expect(resourceConfigurationService.Service.GetServiceURL()).To(Equal(resourceconfigurationv1.DefaultServiceURL))
+			Expect(resourceConfigurationService.ServiceInstanceID).To(Equal("dev-instance"))
+			authenticator, ok := resourceConfigurationService.Service.Options.Authenticator.(*core.IamAuthenticator)
+			Expect(ok).To(BeTrue())
+			Expect(authenticator.ApiKey).To(Equal("env-api-key"))
+		})
+		It(`Invoke NewFromProfile with a named profile`, func() {
+			resourceConfigurationService, err := resourceconfigurationv1.NewFromProfile("prod")
+			Expect(err).To(BeNil())
+			Expect(resourceConfigurationService.Service.GetServiceURL()).To(Equal("https://config.private.us-south.cloud-object-storage.cloud.ibm.com/v1"))
+			Expect(resourceConfigurationService.ServiceInstanceID).To(Equal("prod-instance"))
+			authenticator := resourceConfigurationService.Service.Options.Authenticator.(*core.IamAuthenticator)
+			Expect(authenticator.ApiKey).To(Equal("file-api-key"))
+			Expect(authenticator.URL).To(Equal("https://private.iam.cloud.ibm.com"))
+
+			resourceConfigurationService, err = resourceconfigurationv1.NewFromProfile("local")
+			Expect(err).To(BeNil())
+			Expect(resourceConfigurationService.Service.GetServiceURL()).To(Equal("http://localhost:8080/v1"))
+		})
+		It(`Invoke NewFromProfile with the profile from the environment`, func() {
+			os.Setenv(resourceconfigurationv1.ProfileEnv, "local")
+			resourceConfigurationService, err := resourceconfigurationv1.NewFromProfile("")
+			Expect(err).To(BeNil())
+			Expect(resourceConfigurationService.Service.GetServiceURL()).To(Equal("http://localhost:8080/v1"))
+		})
+		It(`Invoke NewFromProfile with error: profile not found`, func() {
+			resourceConfigurationService, err := resourceconfigurationv1.NewFromProfile("staging")
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring(`profile "staging" not found (available profiles: dev, local, prod)`))
+			Expect(resourceConfigurationService).To(BeNil())
+		})
+		It(`Invoke NewFromProfile with another profile invalid`, func() {
+			writeConfig(`
+profiles:
+  dev:
+    apikey_env: TEST_PROFILE_API_KEY
+  broken:
+    apikey_env: TEST_PROFILE_API_KEY
+    endpoint_type: vpc
+`, 0600)
+			resourceConfigurationService, err := resourceconfigurationv1.NewFromProfile("dev")
+			Expect(err).To(BeNil())
+			Expect(resourceConfigurationService).ToNot(BeNil())
+
+			resourceConfigurationService, err = resourceconfigurationv1.NewFromProfile("broken")
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring(`invalid profile "broken"`))
+			Expect(resourceConfigurationService).To(BeNil())
+		})
+		It(`Invoke NewFromProfile with error: API key variable not set`, func() {
+			os.Unsetenv("TEST_PROFILE_API_KEY")
+			resourceConfigurationService, err := resourceconfigurationv1.NewFromProfile("dev")
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("environment variable TEST_PROFILE_API_KEY is not set"))
+			Expect(resourceConfigurationService).To(BeNil())
+		})
+	})
+	Describe(`LoadProfileConfig(path string)`, func() {
+		It(`Invoke LoadProfileConfig with error: missing file`, func() {
+			config, err := resourceconfigurationv1.LoadProfileConfig(filepath.Join(dir, "missing.yaml"))
+			Expect(err).ToNot(BeNil())
+			Expect(config).To(BeNil())
+		})
+		It(`Invoke LoadProfileConfig with error: unknown field`, func() {
+			writeConfig("profiles:\n  dev:\n    api_key: x\n", 0600)
+			_, err := resourceconfigurationv1.LoadProfileConfig(configPath)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("invalid profile file"))
+		})
+		It(`Invoke Validate with error: invalid profiles`, func() {
+			for contents, message := range map[string]string{
+				"profiles:\n  dev:\n    iam_url: x\n":                                           "exactly one of apikey, apikey_env and apikey_file must be set",
+				"profiles:\n  dev:\n    apikey: x\n    apikey_env: Y\n":                         "exactly one of apikey, apikey_env and apikey_file must be set",
+				"profiles:\n  dev:\n    apikey_env: Y\n    endpoint_type: vpc\n":                `endpoint type "vpc" is not one of public, private or direct`,
+				"profiles:\n  dev:\n    apikey_env: Y\n    endpoint_type: direct\n    url: x\n": "url cannot be combined with region or endpoint_type",
+			} {
+				writeConfig(contents, 0600)
+				config, err := resourceconfigurationv1.LoadProfileConfig(configPath)
+				Expect(err).To(BeNil())
+				err = config.Validate()
+				Expect(err).ToNot(BeNil())
+				Expect(err.Error()).To(ContainSubstring(`invalid profile "dev" in ` + configPath))
+				Expect(err.Error()).To(ContainSubstring(message))
+				profile, err := config.GetProfile("dev")
+				Expect(err).ToNot(BeNil())
+				Expect(err.Error()).To(ContainSubstring(message))
+				Expect(profile).To(BeNil())
+			}
+		})
+		It(`Invoke LoadProfileConfig with error: inline API key in a shared file`, func() {
+			if runtime.GOOS == "windows" {
+				Skip("file permissions are not checked on windows")
+			}
+			writeConfig("profiles:\n  default:\n    apikey: x\n  shared:\n    apikey_env: Y\n", 0644)
+			config, err := resourceconfigurationv1.LoadProfileConfig(configPath)
+			Expect(err).To(BeNil())
+			err = config.Validate()
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("readable by other users"))
+			_, err = config.GetProfile("default")
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("readable by other users"))
+			_, err = config.GetProfile("shared")
+			Expect(err).To(BeNil())
+
+			writeConfig("profiles:\n  default:\n    apikey_env: Y\n", 0644)
+			config, err = resourceconfigurationv1.LoadProfileConfig(configPath)
+			Expect(err).To(BeNil())
+			Expect(config.Validate()).To(Succeed())
+		})
+	})
+})
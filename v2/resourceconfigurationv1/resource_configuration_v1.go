@@ -25,6 +25,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"log/slog"
 	"net/http"
 	"reflect"
 	"time"
@@ -32,6 +33,8 @@ import (
 	"github.com/IBM/go-sdk-core/v5/core"
 	common "github.com/IBM/ibm-cos-sdk-go-config/v2/common"
 	"github.com/go-openapi/strfmt"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // ResourceConfigurationV1 : REST API used to configure Cloud Object Storage buckets.
@@ -39,6 +42,31 @@ import (
 // API Version: 1.0.0
 type ResourceConfigurationV1 struct {
 	Service *core.BaseService
+
+	// The service instance ID configured for this client, e.g. by the profile it was created from.
+	// It is not sent with requests; callers use it when building options that take a service instance ID.
+	ServiceInstanceID string
+
+	// Whether operations check their options with their Validate method before sending the request.
+	clientValidation bool
+
+	// Receives a measurement of every operation, if set.
+	requestMetrics RequestMetrics
+
+	// Starts the spans of operations; the global tracer provider if nil.
+	tracerProvider trace.TracerProvider
+
+	// Adds the trace context to requests; W3C Trace Context if nil.
+	textMapPropagator propagation.TextMapPropagator
+
+	// Receives a record of every operation, if set.
+	logger *slog.Logger
+
+	// Whether the firewall IP addresses are redacted from logged bodies.
+	logRedactFirewall bool
+
+	// Hooks called before and after every request, in order.
+	middleware []Middleware
 }
 
 // DefaultServiceURL is the default URL to make service requests to.
@@ -52,6 +80,12 @@ type ResourceConfigurationV1Options struct {
 	ServiceName   string
 	URL           string
 	Authenticator core.Authenticator
+
+	// The region whose endpoint is used, or "global". Cannot be combined with URL.
+	Region string
+
+	// The network used to reach the service: "public", "private" or "direct". Cannot be combined with URL.
+	EndpointType string
 }
 
 // NewResourceConfigurationV1UsingExternalConfig : constructs an instance of ResourceConfigurationV1 with passed in options and external configuration.
@@ -80,8 +114,13 @@ func NewResourceConfigurationV1UsingExternalConfig(options *ResourceConfiguratio
 		return
 	}
 
-	if options.URL != "" {
-		err = resourceConfiguration.Service.SetServiceURL(options.URL)
+	url, err := options.resolveServiceURL()
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "endpoint-error")
+		return
+	}
+	if url != "" {
+		err = resourceConfiguration.Service.SetServiceURL(url)
 		err = core.RepurposeSDKProblem(err, "url-set-error")
 	}
 	return
@@ -100,8 +139,13 @@ func NewResourceConfigurationV1(options *ResourceConfigurationV1Options) (servic
 		return
 	}
 
-	if options.URL != "" {
-		err = baseService.SetServiceURL(options.URL)
+	url, err := options.resolveServiceURL()
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "endpoint-error")
+		return
+	}
+	if url != "" {
+		err = baseService.SetServiceURL(url)
 		if err != nil {
 			err = core.SDKErrorf(err, "", "set-url-error", common.GetComponentInfo())
 			return
@@ -117,7 +161,11 @@ func NewResourceConfigurationV1(options *ResourceConfigurationV1Options) (servic
 
 // GetServiceURLForRegion returns the service URL to be used for the specified region
 func GetServiceURLForRegion(region string) (string, error) {
-	return "", core.SDKErrorf(nil, "service does not support regional URLs", "no-regional-support", common.GetComponentInfo())
+	url, err := GetServiceURLForEndpoint(region, EndpointTypePublic)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "region-url-error")
+	}
+	return url, err
 }
 
 // Clone makes a copy of "resourceConfiguration" suitable for processing requests.
@@ -194,6 +242,9 @@ func (resourceConfiguration *ResourceConfigurationV1) CreateBackupPolicy(createB
 
 // CreateBackupPolicyWithContext is an alternate form of the CreateBackupPolicy method which supports a Context parameter
 func (resourceConfiguration *ResourceConfigurationV1) CreateBackupPolicyWithContext(ctx context.Context, createBackupPolicyOptions *CreateBackupPolicyOptions) (result *BackupPolicy, response *core.DetailedResponse, err error) {
+	ctx, span := resourceConfiguration.startSpan(ctx, "CreateBackupPolicy", createBackupPolicyOptions)
+	defer func() { endSpan(span, response, err) }()
+
 	err = core.ValidateNotNil(createBackupPolicyOptions, "createBackupPolicyOptions cannot be nil")
 	if err != nil {
 		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
@@ -204,6 +255,11 @@ func (resourceConfiguration *ResourceConfigurationV1) CreateBackupPolicyWithCont
 		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
 		return
 	}
+	err = resourceConfiguration.validateOptions(createBackupPolicyOptions)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "client-validation-error", common.GetComponentInfo())
+		return
+	}
 
 	pathParamsMap := map[string]string{
 		"bucket": *createBackupPolicyOptions.Bucket,
@@ -258,7 +314,7 @@ func (resourceConfiguration *ResourceConfigurationV1) CreateBackupPolicyWithCont
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = resourceConfiguration.Service.Request(request, &rawResponse)
+	response, err = resourceConfiguration.invoke("CreateBackupPolicy", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_backup_policy", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -290,6 +346,9 @@ func (resourceConfiguration *ResourceConfigurationV1) ListBackupPolicies(listBac
 
 // ListBackupPoliciesWithContext is an alternate form of the ListBackupPolicies method which supports a Context parameter
 func (resourceConfiguration *ResourceConfigurationV1) ListBackupPoliciesWithContext(ctx context.Context, listBackupPoliciesOptions *ListBackupPoliciesOptions) (result *BackupPolicyCollection, response *core.DetailedResponse, err error) {
+	ctx, span := resourceConfiguration.startSpan(ctx, "ListBackupPolicies", listBackupPoliciesOptions)
+	defer func() { endSpan(span, response, err) }()
+
 	err = core.ValidateNotNil(listBackupPoliciesOptions, "listBackupPoliciesOptions cannot be nil")
 	if err != nil {
 		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
@@ -300,6 +359,11 @@ func (resourceConfiguration *ResourceConfigurationV1) ListBackupPoliciesWithCont
 		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
 		return
 	}
+	err = resourceConfiguration.validateOptions(listBackupPoliciesOptions)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "client-validation-error", common.GetComponentInfo())
+		return
+	}
 
 	pathParamsMap := map[string]string{
 		"bucket": *listBackupPoliciesOptions.Bucket,
@@ -331,7 +395,7 @@ func (resourceConfiguration *ResourceConfigurationV1) ListBackupPoliciesWithCont
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = resourceConfiguration.Service.Request(request, &rawResponse)
+	response, err = resourceConfiguration.invoke("ListBackupPolicies", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_backup_policies", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -361,6 +425,9 @@ func (resourceConfiguration *ResourceConfigurationV1) GetBackupPolicy(getBackupP
 
 // GetBackupPolicyWithContext is an alternate form of the GetBackupPolicy method which supports a Context parameter
 func (resourceConfiguration *ResourceConfigurationV1) GetBackupPolicyWithContext(ctx context.Context, getBackupPolicyOptions *GetBackupPolicyOptions) (result *BackupPolicy, response *core.DetailedResponse, err error) {
+	ctx, span := resourceConfiguration.startSpan(ctx, "GetBackupPolicy", getBackupPolicyOptions)
+	defer func() { endSpan(span, response, err) }()
+
 	err = core.ValidateNotNil(getBackupPolicyOptions, "getBackupPolicyOptions cannot be nil")
 	if err != nil {
 		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
@@ -371,6 +438,11 @@ func (resourceConfiguration *ResourceConfigurationV1) GetBackupPolicyWithContext
 		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
 		return
 	}
+	err = resourceConfiguration.validateOptions(getBackupPolicyOptions)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "client-validation-error", common.GetComponentInfo())
+		return
+	}
 
 	pathParamsMap := map[string]string{
 		"bucket": *getBackupPolicyOptions.Bucket,
@@ -403,7 +475,7 @@ func (resourceConfiguration *ResourceConfigurationV1) GetBackupPolicyWithContext
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = resourceConfiguration.Service.Request(request, &rawResponse)
+	response, err = resourceConfiguration.invoke("GetBackupPolicy", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_backup_policy", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -433,6 +505,9 @@ func (resourceConfiguration *ResourceConfigurationV1) DeleteBackupPolicy(deleteB
 
 // DeleteBackupPolicyWithContext is an alternate form of the DeleteBackupPolicy method which supports a Context parameter
 func (resourceConfiguration *ResourceConfigurationV1) DeleteBackupPolicyWithContext(ctx context.Context, deleteBackupPolicyOptions *DeleteBackupPolicyOptions) (response *core.DetailedResponse, err error) {
+	ctx, span := resourceConfiguration.startSpan(ctx, "DeleteBackupPolicy", deleteBackupPolicyOptions)
+	defer func() { endSpan(span, response, err) }()
+
 	err = core.ValidateNotNil(deleteBackupPolicyOptions, "deleteBackupPolicyOptions cannot be nil")
 	if err != nil {
 		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
@@ -443,6 +518,11 @@ func (resourceConfiguration *ResourceConfigurationV1) DeleteBackupPolicyWithCont
 		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
 		return
 	}
+	err = resourceConfiguration.validateOptions(deleteBackupPolicyOptions)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "client-validation-error", common.GetComponentInfo())
+		return
+	}
 
 	pathParamsMap := map[string]string{
 		"bucket": *deleteBackupPolicyOptions.Bucket,
@@ -473,7 +553,7 @@ func (resourceConfiguration *ResourceConfigurationV1) DeleteBackupPolicyWithCont
 		return
 	}
 
-	response, err = resourceConfiguration.Service.Request(request, nil)
+	response, err = resourceConfiguration.invoke("DeleteBackupPolicy", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_backup_policy", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -496,6 +576,9 @@ func (resourceConfiguration *ResourceConfigurationV1) ListBackupVaults(listBacku
 
 // ListBackupVaultsWithContext is an alternate form of the ListBackupVaults method which supports a Context parameter
 func (resourceConfiguration *ResourceConfigurationV1) ListBackupVaultsWithContext(ctx context.Context, listBackupVaultsOptions *ListBackupVaultsOptions) (result *BackupVaultCollection, response *core.DetailedResponse, err error) {
+	ctx, span := resourceConfiguration.startSpan(ctx, "ListBackupVaults", listBackupVaultsOptions)
+	defer func() { endSpan(span, response, err) }()
+
 	err = core.ValidateNotNil(listBackupVaultsOptions, "listBackupVaultsOptions cannot be nil")
 	if err != nil {
 		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
@@ -506,6 +589,11 @@ func (resourceConfiguration *ResourceConfigurationV1) ListBackupVaultsWithContex
 		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
 		return
 	}
+	err = resourceConfiguration.validateOptions(listBackupVaultsOptions)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "client-validation-error", common.GetComponentInfo())
+		return
+	}
 
 	builder := core.NewRequestBuilder(core.GET)
 	builder = builder.WithContext(ctx)
@@ -538,7 +626,7 @@ func (resourceConfiguration *ResourceConfigurationV1) ListBackupVaultsWithContex
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = resourceConfiguration.Service.Request(request, &rawResponse)
+	response, err = resourceConfiguration.invoke("ListBackupVaults", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_backup_vaults", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -572,6 +660,9 @@ func (resourceConfiguration *ResourceConfigurationV1) CreateBackupVault(createBa
 
 // CreateBackupVaultWithContext is an alternate form of the CreateBackupVault method which supports a Context parameter
 func (resourceConfiguration *ResourceConfigurationV1) CreateBackupVaultWithContext(ctx context.Context, createBackupVaultOptions *CreateBackupVaultOptions) (result *BackupVault, response *core.DetailedResponse, err error) {
+	ctx, span := resourceConfiguration.startSpan(ctx, "CreateBackupVault", createBackupVaultOptions)
+	defer func() { endSpan(span, response, err) }()
+
 	err = core.ValidateNotNil(createBackupVaultOptions, "createBackupVaultOptions cannot be nil")
 	if err != nil {
 		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
@@ -582,6 +673,11 @@ func (resourceConfiguration *ResourceConfigurationV1) CreateBackupVaultWithConte
 		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
 		return
 	}
+	err = resourceConfiguration.validateOptions(createBackupVaultOptions)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "client-validation-error", common.GetComponentInfo())
+		return
+	}
 
 	builder := core.NewRequestBuilder(core.POST)
 	builder = builder.WithContext(ctx)
@@ -634,7 +730,7 @@ func (resourceConfiguration *ResourceConfigurationV1) CreateBackupVaultWithConte
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = resourceConfiguration.Service.Request(request, &rawResponse)
+	response, err = resourceConfiguration.invoke("CreateBackupVault", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_backup_vault", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -669,6 +765,9 @@ func (resourceConfiguration *ResourceConfigurationV1) GetBackupVault(getBackupVa
 
 // GetBackupVaultWithContext is an alternate form of the GetBackupVault method which supports a Context parameter
 func (resourceConfiguration *ResourceConfigurationV1) GetBackupVaultWithContext(ctx context.Context, getBackupVaultOptions *GetBackupVaultOptions) (result *BackupVault, response *core.DetailedResponse, err error) {
+	ctx, span := resourceConfiguration.startSpan(ctx, "GetBackupVault", getBackupVaultOptions)
+	defer func() { endSpan(span, response, err) }()
+
 	err = core.ValidateNotNil(getBackupVaultOptions, "getBackupVaultOptions cannot be nil")
 	if err != nil {
 		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
@@ -679,6 +778,11 @@ func (resourceConfiguration *ResourceConfigurationV1) GetBackupVaultWithContext(
 		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
 		return
 	}
+	err = resourceConfiguration.validateOptions(getBackupVaultOptions)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "client-validation-error", common.GetComponentInfo())
+		return
+	}
 
 	pathParamsMap := map[string]string{
 		"backup_vault_name": *getBackupVaultOptions.BackupVaultName,
@@ -710,7 +814,7 @@ func (resourceConfiguration *ResourceConfigurationV1) GetBackupVaultWithContext(
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = resourceConfiguration.Service.Request(request, &rawResponse)
+	response, err = resourceConfiguration.invoke("GetBackupVault", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_backup_vault", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -745,6 +849,9 @@ func (resourceConfiguration *ResourceConfigurationV1) UpdateBackupVault(updateBa
 
 // UpdateBackupVaultWithContext is an alternate form of the UpdateBackupVault method which supports a Context parameter
 func (resourceConfiguration *ResourceConfigurationV1) UpdateBackupVaultWithContext(ctx context.Context, updateBackupVaultOptions *UpdateBackupVaultOptions) (result *BackupVault, response *core.DetailedResponse, err error) {
+	ctx, span := resourceConfiguration.startSpan(ctx, "UpdateBackupVault", updateBackupVaultOptions)
+	defer func() { endSpan(span, response, err) }()
+
 	err = core.ValidateNotNil(updateBackupVaultOptions, "updateBackupVaultOptions cannot be nil")
 	if err != nil {
 		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
@@ -755,6 +862,11 @@ func (resourceConfiguration *ResourceConfigurationV1) UpdateBackupVaultWithConte
 		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
 		return
 	}
+	err = resourceConfiguration.validateOptions(updateBackupVaultOptions)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "client-validation-error", common.GetComponentInfo())
+		return
+	}
 
 	pathParamsMap := map[string]string{
 		"backup_vault_name": *updateBackupVaultOptions.BackupVaultName,
@@ -796,7 +908,7 @@ func (resourceConfiguration *ResourceConfigurationV1) UpdateBackupVaultWithConte
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = resourceConfiguration.Service.Request(request, &rawResponse)
+	response, err = resourceConfiguration.invoke("UpdateBackupVault", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_backup_vault", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -827,6 +939,9 @@ func (resourceConfiguration *ResourceConfigurationV1) DeleteBackupVault(deleteBa
 
 // DeleteBackupVaultWithContext is an alternate form of the DeleteBackupVault method which supports a Context parameter
 func (resourceConfiguration *ResourceConfigurationV1) DeleteBackupVaultWithContext(ctx context.Context, deleteBackupVaultOptions *DeleteBackupVaultOptions) (response *core.DetailedResponse, err error) {
+	ctx, span := resourceConfiguration.startSpan(ctx, "DeleteBackupVault", deleteBackupVaultOptions)
+	defer func() { endSpan(span, response, err) }()
+
 	err = core.ValidateNotNil(deleteBackupVaultOptions, "deleteBackupVaultOptions cannot be nil")
 	if err != nil {
 		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
@@ -837,6 +952,11 @@ func (resourceConfiguration *ResourceConfigurationV1) DeleteBackupVaultWithConte
 		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
 		return
 	}
+	err = resourceConfiguration.validateOptions(deleteBackupVaultOptions)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "client-validation-error", common.GetComponentInfo())
+		return
+	}
 
 	pathParamsMap := map[string]string{
 		"backup_vault_name": *deleteBackupVaultOptions.BackupVaultName,
@@ -866,7 +986,7 @@ func (resourceConfiguration *ResourceConfigurationV1) DeleteBackupVaultWithConte
 		return
 	}
 
-	response, err = resourceConfiguration.Service.Request(request, nil)
+	response, err = resourceConfiguration.invoke("DeleteBackupVault", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_backup_vault", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -886,6 +1006,9 @@ func (resourceConfiguration *ResourceConfigurationV1) GetBucketConfig(getBucketC
 
 // GetBucketConfigWithContext is an alternate form of the GetBucketConfig method which supports a Context parameter
 func (resourceConfiguration *ResourceConfigurationV1) GetBucketConfigWithContext(ctx context.Context, getBucketConfigOptions *GetBucketConfigOptions) (result *Bucket, response *core.DetailedResponse, err error) {
+	ctx, span := resourceConfiguration.startSpan(ctx, "GetBucketConfig", getBucketConfigOptions)
+	defer func() { endSpan(span, response, err) }()
+
 	err = core.ValidateNotNil(getBucketConfigOptions, "getBucketConfigOptions cannot be nil")
 	if err != nil {
 		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
@@ -896,6 +1019,11 @@ func (resourceConfiguration *ResourceConfigurationV1) GetBucketConfigWithContext
 		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
 		return
 	}
+	err = resourceConfiguration.validateOptions(getBucketConfigOptions)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "client-validation-error", common.GetComponentInfo())
+		return
+	}
 
 	pathParamsMap := map[string]string{
 		"bucket": *getBucketConfigOptions.Bucket,
@@ -927,7 +1055,7 @@ func (resourceConfiguration *ResourceConfigurationV1) GetBucketConfigWithContext
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = resourceConfiguration.Service.Request(request, &rawResponse)
+	response, err = resourceConfiguration.invoke("GetBucketConfig", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "getBucketConfig", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -960,6 +1088,9 @@ func (resourceConfiguration *ResourceConfigurationV1) UpdateBucketConfig(updateB
 
 // UpdateBucketConfigWithContext is an alternate form of the UpdateBucketConfig method which supports a Context parameter
 func (resourceConfiguration *ResourceConfigurationV1) UpdateBucketConfigWithContext(ctx context.Context, updateBucketConfigOptions *UpdateBucketConfigOptions) (response *core.DetailedResponse, err error) {
+	ctx, span := resourceConfiguration.startSpan(ctx, "UpdateBucketConfig", updateBucketConfigOptions)
+	defer func() { endSpan(span, response, err) }()
+
 	err = core.ValidateNotNil(updateBucketConfigOptions, "updateBucketConfigOptions cannot be nil")
 	if err != nil {
 		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
@@ -970,6 +1101,11 @@ func (resourceConfiguration *ResourceConfigurationV1) UpdateBucketConfigWithCont
 		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
 		return
 	}
+	err = resourceConfiguration.validateOptions(updateBucketConfigOptions)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "client-validation-error", common.GetComponentInfo())
+		return
+	}
 
 	pathParamsMap := map[string]string{
 		"bucket": *updateBucketConfigOptions.Bucket,
@@ -1011,7 +1147,7 @@ func (resourceConfiguration *ResourceConfigurationV1) UpdateBucketConfigWithCont
 		return
 	}
 
-	response, err = resourceConfiguration.Service.Request(request, nil)
+	response, err = resourceConfiguration.invoke("UpdateBucketConfig", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "updateBucketConfig", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1034,6 +1170,9 @@ func (resourceConfiguration *ResourceConfigurationV1) ListRecoveryRanges(listRec
 
 // ListRecoveryRangesWithContext is an alternate form of the ListRecoveryRanges method which supports a Context parameter
 func (resourceConfiguration *ResourceConfigurationV1) ListRecoveryRangesWithContext(ctx context.Context, listRecoveryRangesOptions *ListRecoveryRangesOptions) (result *RecoveryRangeCollection, response *core.DetailedResponse, err error) {
+	ctx, span := resourceConfiguration.startSpan(ctx, "ListRecoveryRanges", listRecoveryRangesOptions)
+	defer func() { endSpan(span, response, err) }()
+
 	err = core.ValidateNotNil(listRecoveryRangesOptions, "listRecoveryRangesOptions cannot be nil")
 	if err != nil {
 		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
@@ -1044,6 +1183,11 @@ func (resourceConfiguration *ResourceConfigurationV1) ListRecoveryRangesWithCont
 		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
 		return
 	}
+	err = resourceConfiguration.validateOptions(listRecoveryRangesOptions)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "client-validation-error", common.GetComponentInfo())
+		return
+	}
 
 	pathParamsMap := map[string]string{
 		"backup_vault_name": *listRecoveryRangesOptions.BackupVaultName,
@@ -1085,7 +1229,7 @@ func (resourceConfiguration *ResourceConfigurationV1) ListRecoveryRangesWithCont
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = resourceConfiguration.Service.Request(request, &rawResponse)
+	response, err = resourceConfiguration.invoke("ListRecoveryRanges", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_recovery_ranges", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1115,6 +1259,9 @@ func (resourceConfiguration *ResourceConfigurationV1) GetSourceResourceRecoveryR
 
 // GetSourceResourceRecoveryRangeWithContext is an alternate form of the GetSourceResourceRecoveryRange method which supports a Context parameter
 func (resourceConfiguration *ResourceConfigurationV1) GetSourceResourceRecoveryRangeWithContext(ctx context.Context, getSourceResourceRecoveryRangeOptions *GetSourceResourceRecoveryRangeOptions) (result *RecoveryRange, response *core.DetailedResponse, err error) {
+	ctx, span := resourceConfiguration.startSpan(ctx, "GetSourceResourceRecoveryRange", getSourceResourceRecoveryRangeOptions)
+	defer func() { endSpan(span, response, err) }()
+
 	err = core.ValidateNotNil(getSourceResourceRecoveryRangeOptions, "getSourceResourceRecoveryRangeOptions cannot be nil")
 	if err != nil {
 		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
@@ -1125,6 +1272,11 @@ func (resourceConfiguration *ResourceConfigurationV1) GetSourceResourceRecoveryR
 		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
 		return
 	}
+	err = resourceConfiguration.validateOptions(getSourceResourceRecoveryRangeOptions)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "client-validation-error", common.GetComponentInfo())
+		return
+	}
 
 	pathParamsMap := map[string]string{
 		"backup_vault_name": *getSourceResourceRecoveryRangeOptions.BackupVaultName,
@@ -1157,7 +1309,7 @@ func (resourceConfiguration *ResourceConfigurationV1) GetSourceResourceRecoveryR
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = resourceConfiguration.Service.Request(request, &rawResponse)
+	response, err = resourceConfiguration.invoke("GetSourceResourceRecoveryRange", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_source_resource_recovery_range", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1189,6 +1341,9 @@ func (resourceConfiguration *ResourceConfigurationV1) PatchSourceResourceRecover
 
 // PatchSourceResourceRecoveryRangeWithContext is an alternate form of the PatchSourceResourceRecoveryRange method which supports a Context parameter
 func (resourceConfiguration *ResourceConfigurationV1) PatchSourceResourceRecoveryRangeWithContext(ctx context.Context, patchSourceResourceRecoveryRangeOptions *PatchSourceResourceRecoveryRangeOptions) (result *RecoveryRange, response *core.DetailedResponse, err error) {
+	ctx, span := resourceConfiguration.startSpan(ctx, "PatchSourceResourceRecoveryRange", patchSourceResourceRecoveryRangeOptions)
+	defer func() { endSpan(span, response, err) }()
+
 	err = core.ValidateNotNil(patchSourceResourceRecoveryRangeOptions, "patchSourceResourceRecoveryRangeOptions cannot be nil")
 	if err != nil {
 		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
@@ -1199,6 +1354,11 @@ func (resourceConfiguration *ResourceConfigurationV1) PatchSourceResourceRecover
 		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
 		return
 	}
+	err = resourceConfiguration.validateOptions(patchSourceResourceRecoveryRangeOptions)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "client-validation-error", common.GetComponentInfo())
+		return
+	}
 
 	pathParamsMap := map[string]string{
 		"backup_vault_name": *patchSourceResourceRecoveryRangeOptions.BackupVaultName,
@@ -1238,7 +1398,7 @@ func (resourceConfiguration *ResourceConfigurationV1) PatchSourceResourceRecover
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = resourceConfiguration.Service.Request(request, &rawResponse)
+	response, err = resourceConfiguration.invoke("PatchSourceResourceRecoveryRange", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "patch_source_resource_recovery_range", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1275,6 +1435,9 @@ func (resourceConfiguration *ResourceConfigurationV1) CreateRestore(createRestor
 
 // CreateRestoreWithContext is an alternate form of the CreateRestore method which supports a Context parameter
 func (resourceConfiguration *ResourceConfigurationV1) CreateRestoreWithContext(ctx context.Context, createRestoreOptions *CreateRestoreOptions) (result *Restore, response *core.DetailedResponse, err error) {
+	ctx, span := resourceConfiguration.startSpan(ctx, "CreateRestore", createRestoreOptions)
+	defer func() { endSpan(span, response, err) }()
+
 	err = core.ValidateNotNil(createRestoreOptions, "createRestoreOptions cannot be nil")
 	if err != nil {
 		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
@@ -1285,6 +1448,11 @@ func (resourceConfiguration *ResourceConfigurationV1) CreateRestoreWithContext(c
 		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
 		return
 	}
+	err = resourceConfiguration.validateOptions(createRestoreOptions)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "client-validation-error", common.GetComponentInfo())
+		return
+	}
 
 	pathParamsMap := map[string]string{
 		"backup_vault_name": *createRestoreOptions.BackupVaultName,
@@ -1336,7 +1504,7 @@ func (resourceConfiguration *ResourceConfigurationV1) CreateRestoreWithContext(c
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = resourceConfiguration.Service.Request(request, &rawResponse)
+	response, err = resourceConfiguration.invoke("CreateRestore", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_restore", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1366,6 +1534,9 @@ func (resourceConfiguration *ResourceConfigurationV1) ListRestores(listRestoresO
 
 // ListRestoresWithContext is an alternate form of the ListRestores method which supports a Context parameter
 func (resourceConfiguration *ResourceConfigurationV1) ListRestoresWithContext(ctx context.Context, listRestoresOptions *ListRestoresOptions) (result *RestoreCollection, response *core.DetailedResponse, err error) {
+	ctx, span := resourceConfiguration.startSpan(ctx, "ListRestores", listRestoresOptions)
+	defer func() { endSpan(span, response, err) }()
+
 	err = core.ValidateNotNil(listRestoresOptions, "listRestoresOptions cannot be nil")
 	if err != nil {
 		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
@@ -1376,6 +1547,11 @@ func (resourceConfiguration *ResourceConfigurationV1) ListRestoresWithContext(ct
 		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
 		return
 	}
+	err = resourceConfiguration.validateOptions(listRestoresOptions)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "client-validation-error", common.GetComponentInfo())
+		return
+	}
 
 	pathParamsMap := map[string]string{
 		"backup_vault_name": *listRestoresOptions.BackupVaultName,
@@ -1411,7 +1587,7 @@ func (resourceConfiguration *ResourceConfigurationV1) ListRestoresWithContext(ct
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = resourceConfiguration.Service.Request(request, &rawResponse)
+	response, err = resourceConfiguration.invoke("ListRestores", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_restores", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1441,6 +1617,9 @@ func (resourceConfiguration *ResourceConfigurationV1) GetRestore(getRestoreOptio
 
 // GetRestoreWithContext is an alternate form of the GetRestore method which supports a Context parameter
 func (resourceConfiguration *ResourceConfigurationV1) GetRestoreWithContext(ctx context.Context, getRestoreOptions *GetRestoreOptions) (result *Restore, response *core.DetailedResponse, err error) {
+	ctx, span := resourceConfiguration.startSpan(ctx, "GetRestore", getRestoreOptions)
+	defer func() { endSpan(span, response, err) }()
+
 	err = core.ValidateNotNil(getRestoreOptions, "getRestoreOptions cannot be nil")
 	if err != nil {
 		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
@@ -1451,6 +1630,11 @@ func (resourceConfiguration *ResourceConfigurationV1) GetRestoreWithContext(ctx
 		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
 		return
 	}
+	err = resourceConfiguration.validateOptions(getRestoreOptions)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "client-validation-error", common.GetComponentInfo())
+		return
+	}
 
 	pathParamsMap := map[string]string{
 		"backup_vault_name": *getRestoreOptions.BackupVaultName,
@@ -1483,7 +1667,7 @@ func (resourceConfiguration *ResourceConfigurationV1) GetRestoreWithContext(ctx
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = resourceConfiguration.Service.Request(request, &rawResponse)
+	response, err = resourceConfiguration.invoke("GetRestore", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_restore", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
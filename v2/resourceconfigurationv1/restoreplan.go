@@ -0,0 +1,255 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourceconfigurationv1
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/ibm-cos-sdk-go-config/v2/common"
+	"github.com/IBM/ibm-cos-sdk-go-config/v2/crn"
+	"github.com/go-openapi/strfmt"
+)
+
+// Constants associated with the PreflightCheck.Name property.
+// The CreateRestore precondition that was checked.
+const (
+	PreflightCheck_Name_RecoveryRangeExists    = "recovery_range_exists"
+	PreflightCheck_Name_PointInTimeInRange     = "point_in_time_in_range"
+	PreflightCheck_Name_TargetResourceCrnValid = "target_resource_crn_valid"
+	PreflightCheck_Name_NoRestoreInProgress    = "no_restore_in_progress"
+)
+
+// restorePlanChecks are the checks a RestorePlan must contain to be started.
+var restorePlanChecks = []string{
+	PreflightCheck_Name_RecoveryRangeExists,
+	PreflightCheck_Name_PointInTimeInRange,
+	PreflightCheck_Name_TargetResourceCrnValid,
+	PreflightCheck_Name_NoRestoreInProgress,
+}
+
+// RestorePlan : The outcome of checking a restore without starting it. A plan whose checks all passed can be started
+// with ExecuteRestorePlan.
+type RestorePlan struct {
+	PreflightReport
+
+	// The options the restore is started with: a copy of those that were checked, so that later changes to the
+	// options passed to PlanRestore do not affect the plan.
+	Options *CreateRestoreOptions `json:"options"`
+
+	// The RecoveryRange the restore reads from, if it exists.
+	RecoveryRange *RecoveryRange `json:"recovery_range,omitempty"`
+
+	// The restores of the BackupVault to the same target that are `initializing` or `running`.
+	ConflictingRestores []Restore `json:"conflicting_restores,omitempty"`
+
+	// The configuration and usage of the target bucket, which the restore overwrites. Only present when the target CRN
+	// is a bucket CRN and the bucket could be read.
+	TargetBucket *Bucket `json:"target_bucket,omitempty"`
+}
+
+// PlanRestore : Check a restore without starting it
+// Checks that the RecoveryRange exists and contains the restore_point_in_time, that the target_resource_crn is a
+// well-formed bucket CRN, and that no other restore of the BackupVault to the same target is `initializing` or
+// `running`. The configuration of the target bucket is included, when it can be read, to show what the restore
+// overwrites.
+//
+// Failed checks are reported in the plan, not as an error; an error is returned only when the checks could not be
+// made.
+func (resourceConfiguration *ResourceConfigurationV1) PlanRestore(createRestoreOptions *CreateRestoreOptions) (result *RestorePlan, err error) {
+	result, err = resourceConfiguration.PlanRestoreWithContext(context.Background(), createRestoreOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// PlanRestoreWithContext is an alternate form of the PlanRestore method which supports a Context parameter
+func (resourceConfiguration *ResourceConfigurationV1) PlanRestoreWithContext(ctx context.Context, createRestoreOptions *CreateRestoreOptions) (result *RestorePlan, err error) {
+	err = core.ValidateNotNil(createRestoreOptions, "createRestoreOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(createRestoreOptions, "createRestoreOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	createRestoreOptions = copyCreateRestoreOptions(createRestoreOptions)
+	plan := &RestorePlan{
+		Options: createRestoreOptions,
+	}
+	vaultName := *createRestoreOptions.BackupVaultName
+	rangeID := *createRestoreOptions.RecoveryRangeID
+	pointInTime := time.Time(*createRestoreOptions.RestorePointInTime)
+
+	getRangeOptions := resourceConfiguration.NewGetSourceResourceRecoveryRangeOptions(vaultName, rangeID)
+	getRangeOptions.SetHeaders(createRestoreOptions.Headers)
+	recoveryRange, response, err := resourceConfiguration.GetSourceResourceRecoveryRangeWithContext(ctx, getRangeOptions)
+	switch {
+	case err == nil:
+		plan.RecoveryRange = recoveryRange
+		plan.add(PreflightCheck_Name_RecoveryRangeExists, true, "recovery range %s exists in backup vault %s", rangeID, vaultName)
+		if rangeContains(recoveryRange, pointInTime) {
+			plan.add(PreflightCheck_Name_PointInTimeInRange, true, "recovery range %s contains %s", rangeID, formatTime(pointInTime))
+		} else {
+			plan.add(PreflightCheck_Name_PointInTimeInRange, false, "recovery range %s covers %s to %s, not %s", rangeID,
+				formatDateTime(recoveryRange.RangeStartTime), formatDateTime(recoveryRange.RangeEndTime), formatTime(pointInTime))
+		}
+	case response != nil && response.StatusCode == http.StatusNotFound:
+		plan.add(PreflightCheck_Name_RecoveryRangeExists, false, "recovery range %s does not exist in backup vault %s", rangeID, vaultName)
+		plan.add(PreflightCheck_Name_PointInTimeInRange, false, "recovery range %s does not exist", rangeID)
+	default:
+		err = core.RepurposeSDKProblem(err, "plan-get-recovery-range-error")
+		return
+	}
+
+	targetCrn := *createRestoreOptions.TargetResourceCrn
+	target, parseErr := crn.ParseBucket(targetCrn)
+	if parseErr != nil {
+		plan.add(PreflightCheck_Name_TargetResourceCrnValid, false, "%s", parseErr.Error())
+	} else {
+		plan.add(PreflightCheck_Name_TargetResourceCrnValid, true, "%s is the CRN of bucket %s", targetCrn, target.Resource)
+	}
+
+	listRestoresOptions := resourceConfiguration.NewListRestoresOptions(vaultName)
+	listRestoresOptions.SetHeaders(createRestoreOptions.Headers)
+	pager, err := resourceConfiguration.NewRestoresPager(listRestoresOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "plan-list-restores-error")
+		return
+	}
+	restores, err := pager.GetAllWithContext(ctx)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "plan-list-restores-error")
+		return
+	}
+	var conflicting []string
+	for _, restore := range restores {
+		status := core.StringNilMapper(restore.RestoreStatus)
+		if core.StringNilMapper(restore.TargetResourceCrn) == targetCrn &&
+			(status == Restore_RestoreStatus_Initializing || status == Restore_RestoreStatus_Running) {
+			plan.ConflictingRestores = append(plan.ConflictingRestores, restore)
+			conflicting = append(conflicting, core.StringNilMapper(restore.RestoreID))
+		}
+	}
+	if len(conflicting) > 0 {
+		plan.add(PreflightCheck_Name_NoRestoreInProgress, false, "restores %s to the same target are in progress", strings.Join(conflicting, ", "))
+	} else {
+		plan.add(PreflightCheck_Name_NoRestoreInProgress, true, "no restore to the same target is in progress")
+	}
+
+	if target != nil {
+		getBucketConfigOptions := resourceConfiguration.NewGetBucketConfigOptions(target.Resource)
+		getBucketConfigOptions.SetHeaders(createRestoreOptions.Headers)
+		bucket, _, bucketErr := resourceConfiguration.GetBucketConfigWithContext(ctx, getBucketConfigOptions)
+		if bucketErr != nil {
+			core.GetLogger().Debug("Unable to read target bucket %s: %s\n", target.Resource, bucketErr.Error())
+		}
+		plan.TargetBucket = bucket
+	}
+
+	result = plan
+	return
+}
+
+// ExecuteRestorePlan : Start a planned restore
+// Starts the restore of a plan returned by PlanRestore. Plans with failed checks, and plans that lack any of the checks
+// PlanRestore makes, are refused; plan again once the problems are resolved.
+func (resourceConfiguration *ResourceConfigurationV1) ExecuteRestorePlan(restorePlan *RestorePlan) (result *Restore, response *core.DetailedResponse, err error) {
+	result, response, err = resourceConfiguration.ExecuteRestorePlanWithContext(context.Background(), restorePlan)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ExecuteRestorePlanWithContext is an alternate form of the ExecuteRestorePlan method which supports a Context parameter
+func (resourceConfiguration *ResourceConfigurationV1) ExecuteRestorePlanWithContext(ctx context.Context, restorePlan *RestorePlan) (result *Restore, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(restorePlan, "restorePlan cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	missing := []string{}
+	for _, name := range restorePlanChecks {
+		found := false
+		for _, check := range restorePlan.Checks {
+			found = found || check.Name == name
+		}
+		if !found {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		err = core.SDKErrorf(nil, "the restore plan lacks the checks "+strings.Join(missing, ", ")+"; create it with PlanRestore",
+			"restore-plan-incomplete", common.GetComponentInfo())
+		return
+	}
+	if !restorePlan.Passed() {
+		names := []string{}
+		for _, check := range restorePlan.Failures() {
+			names = append(names, check.Name)
+		}
+		err = core.SDKErrorf(nil, "the restore plan failed its checks: "+strings.Join(names, ", "), "restore-plan-failed", common.GetComponentInfo())
+		return
+	}
+
+	result, response, err = resourceConfiguration.CreateRestoreWithContext(ctx, restorePlan.Options)
+	err = core.RepurposeSDKProblem(err, "execute-restore-plan-error")
+	return
+}
+
+// copyCreateRestoreOptions returns a deep copy of "options".
+func copyCreateRestoreOptions(options *CreateRestoreOptions) *CreateRestoreOptions {
+	copied := &CreateRestoreOptions{
+		BackupVaultName:   copyString(options.BackupVaultName),
+		RecoveryRangeID:   copyString(options.RecoveryRangeID),
+		RestoreType:       copyString(options.RestoreType),
+		TargetResourceCrn: copyString(options.TargetResourceCrn),
+	}
+	if options.RestorePointInTime != nil {
+		restorePointInTime := *options.RestorePointInTime
+		copied.RestorePointInTime = &restorePointInTime
+	}
+	if options.Headers != nil {
+		copied.Headers = make(map[string]string, len(options.Headers))
+		for name, value := range options.Headers {
+			copied.Headers[name] = value
+		}
+	}
+	return copied
+}
+
+func copyString(s *string) *string {
+	if s == nil {
+		return nil
+	}
+	return core.StringPtr(*s)
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+func formatDateTime(dateTime *strfmt.DateTime) string {
+	if dateTime == nil {
+		return "-"
+	}
+	return formatTime(time.Time(*dateTime))
+}
@@ -0,0 +1,192 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourceconfigurationv1_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/ibm-cos-sdk-go-config/v2/resourceconfigurationv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ResourceConfigurationV1 restore plan`, func() {
+	const crnPrefix = "crn:v1:bluemix:public:cloud-object-storage:global:a/3bf0d9003abfb5d29761c3e97696b71c:d6f04d83-6c4f-4a62-a165-696756d63903:"
+	var testServer *httptest.Server
+	var resourceConfigurationService *resourceconfigurationv1.ResourceConfigurationV1
+	var restoresCreated int
+	var restoreBody map[string]interface{}
+	var restoreHeader string
+	BeforeEach(func() {
+		restoresCreated = 0
+		restoreBody = nil
+		restoreHeader = ""
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			switch path := req.URL.EscapedPath(); path {
+			case "/backup_vaults/vault-a/recovery_ranges/range-1":
+				fmt.Fprintf(res, `{"recovery_range_id": "range-1", "source_resource_crn": "%sbucket:source", "range_start_time": "2025-01-01T00:00:00.000Z", "range_end_time": "2025-03-01T00:00:00.000Z"}`, crnPrefix)
+			case "/backup_vaults/vault-a/recovery_ranges/range-missing":
+				res.WriteHeader(404)
+				fmt.Fprint(res, `{"errors": [{"code": "not_found", "message": "recovery range not found"}]}`)
+			case "/backup_vaults/vault-a/restores":
+				if req.Method == "POST" {
+					Expect(json.NewDecoder(req.Body).Decode(&restoreBody)).To(Succeed())
+					restoreHeader = req.Header.Get("X-Test")
+					restoresCreated++
+					res.WriteHeader(201)
+					fmt.Fprintf(res, `{"restore_id": "restore-new", "restore_status": "initializing", "target_resource_crn": "%sbucket:target"}`, crnPrefix)
+					return
+				}
+				fmt.Fprintf(res, `{"restores": [
+					{"restore_id": "restore-1", "restore_status": "running", "target_resource_crn": "%[1]sbucket:busy"},
+					{"restore_id": "restore-2", "restore_status": "complete", "target_resource_crn": "%[1]sbucket:target"},
+					{"restore_id": "restore-3", "restore_status": "initializing", "target_resource_crn": "%[1]sbucket:busy"}
+				]}`, crnPrefix)
+			case "/b/target", "/b/busy":
+				fmt.Fprintf(res, `{"name": "%s", "object_count": 42, "bytes_used": 1024}`, path[len("/b/"):])
+			default:
+				Fail("unexpected request " + req.Method + " " + path)
+			}
+		}))
+		var serviceErr error
+		resourceConfigurationService, serviceErr = resourceconfigurationv1.NewResourceConfigurationV1(&resourceconfigurationv1.ResourceConfigurationV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+	It(`Invoke PlanRestore and ExecuteRestorePlan successfully`, func() {
+		createRestoreOptionsModel := resourceConfigurationService.NewCreateRestoreOptions("vault-a", "range-1", "in_place", CreateMockDateTime("2025-02-01T00:00:00.000Z"), crnPrefix+"bucket:target")
+		plan, operationErr := resourceConfigurationService.PlanRestore(createRestoreOptionsModel)
+		Expect(operationErr).To(BeNil())
+		Expect(plan.Passed()).To(BeTrue())
+		Expect(plan.Checks).To(HaveLen(4))
+		Expect(*plan.RecoveryRange.RecoveryRangeID).To(Equal("range-1"))
+		Expect(plan.ConflictingRestores).To(BeEmpty())
+		Expect(*plan.TargetBucket.ObjectCount).To(Equal(int64(42)))
+		Expect(restoresCreated).To(Equal(0))
+
+		restore, response, operationErr := resourceConfigurationService.ExecuteRestorePlan(plan)
+		Expect(operationErr).To(BeNil())
+		Expect(response.StatusCode).To(Equal(201))
+		Expect(*restore.RestoreID).To(Equal("restore-new"))
+		Expect(restoresCreated).To(Equal(1))
+	})
+	It(`Invoke ExecuteRestorePlan after the planned options changed`, func() {
+		createRestoreOptionsModel := resourceConfigurationService.NewCreateRestoreOptions("vault-a", "range-1", "in_place", CreateMockDateTime("2025-02-01T00:00:00.000Z"), crnPrefix+"bucket:target")
+		createRestoreOptionsModel.SetHeaders(map[string]string{"X-Test": "planned"})
+		plan, operationErr := resourceConfigurationService.PlanRestore(createRestoreOptionsModel)
+		Expect(operationErr).To(BeNil())
+		Expect(plan.Passed()).To(BeTrue())
+
+		*createRestoreOptionsModel.RecoveryRangeID = "range-missing"
+		*createRestoreOptionsModel.RestorePointInTime = *CreateMockDateTime("2025-04-01T00:00:00.000Z")
+		*createRestoreOptionsModel.TargetResourceCrn = crnPrefix + "bucket:busy"
+		createRestoreOptionsModel.Headers["X-Test"] = "changed"
+		createRestoreOptionsModel.SetBackupVaultName("vault-b")
+
+		_, _, operationErr = resourceConfigurationService.ExecuteRestorePlan(plan)
+		Expect(operationErr).To(BeNil())
+		Expect(restoresCreated).To(Equal(1))
+		Expect(restoreBody["recovery_range_id"]).To(Equal("range-1"))
+		Expect(restoreBody["restore_point_in_time"]).To(Equal("2025-02-01T00:00:00.000Z"))
+		Expect(restoreBody["target_resource_crn"]).To(Equal(crnPrefix + "bucket:target"))
+		Expect(restoreHeader).To(Equal("planned"))
+	})
+	It(`Invoke PlanRestore with failing checks`, func() {
+		createRestoreOptionsModel := resourceConfigurationService.NewCreateRestoreOptions("vault-a", "range-1", "in_place", CreateMockDateTime("2025-04-01T00:00:00.000Z"), crnPrefix+"bucket:busy")
+		plan, operationErr := resourceConfigurationService.PlanRestore(createRestoreOptionsModel)
+		Expect(operationErr).To(BeNil())
+		Expect(plan.Passed()).To(BeFalse())
+		failures := plan.Failures()
+		Expect(failures).To(HaveLen(2))
+		Expect(failures[0].Name).To(Equal(resourceconfigurationv1.PreflightCheck_Name_PointInTimeInRange))
+		Expect(failures[0].Message).To(ContainSubstring("2025-01-01T00:00:00Z to 2025-03-01T00:00:00Z"))
+		Expect(failures[1].Name).To(Equal(resourceconfigurationv1.PreflightCheck_Name_NoRestoreInProgress))
+		Expect(failures[1].Message).To(ContainSubstring("restore-1, restore-3"))
+		Expect(plan.ConflictingRestores).To(HaveLen(2))
+
+		restore, response, operationErr := resourceConfigurationService.ExecuteRestorePlan(plan)
+		Expect(operationErr).ToNot(BeNil())
+		Expect(operationErr.Error()).To(ContainSubstring("point_in_time_in_range, no_restore_in_progress"))
+		Expect(response).To(BeNil())
+		Expect(restore).To(BeNil())
+		Expect(restoresCreated).To(Equal(0))
+
+		createRestoreOptionsModel = resourceConfigurationService.NewCreateRestoreOptions("vault-a", "range-missing", "in_place", CreateMockDateTime("2025-02-01T00:00:00.000Z"), "not-a-crn")
+		plan, operationErr = resourceConfigurationService.PlanRestore(createRestoreOptionsModel)
+		Expect(operationErr).To(BeNil())
+		names := []string{}
+		for _, check := range plan.Failures() {
+			names = append(names, check.Name)
+		}
+		Expect(names).To(Equal([]string{
+			resourceconfigurationv1.PreflightCheck_Name_RecoveryRangeExists,
+			resourceconfigurationv1.PreflightCheck_Name_PointInTimeInRange,
+			resourceconfigurationv1.PreflightCheck_Name_TargetResourceCrnValid,
+		}))
+		Expect(plan.RecoveryRange).To(BeNil())
+		Expect(plan.TargetBucket).To(BeNil())
+	})
+	It(`Invoke PlanRestore with error`, func() {
+		plan, operationErr := resourceConfigurationService.PlanRestore(nil)
+		Expect(operationErr).ToNot(BeNil())
+		Expect(plan).To(BeNil())
+
+		plan, operationErr = resourceConfigurationService.PlanRestore(new(resourceconfigurationv1.CreateRestoreOptions))
+		Expect(operationErr).ToNot(BeNil())
+		Expect(plan).To(BeNil())
+
+		restore, response, operationErr := resourceConfigurationService.ExecuteRestorePlan(nil)
+		Expect(operationErr).ToNot(BeNil())
+		Expect(response).To(BeNil())
+		Expect(restore).To(BeNil())
+
+		createRestoreOptionsModel := resourceConfigurationService.NewCreateRestoreOptions("vault-a", "range-1", "in_place", CreateMockDateTime("2025-02-01T00:00:00.000Z"), crnPrefix+"bucket:target")
+		restore, response, operationErr = resourceConfigurationService.ExecuteRestorePlan(&resourceconfigurationv1.RestorePlan{Options: createRestoreOptionsModel})
+		Expect(operationErr).ToNot(BeNil())
+		Expect(operationErr.Error()).To(ContainSubstring("lacks the checks recovery_range_exists, point_in_time_in_range, target_resource_crn_valid, no_restore_in_progress"))
+		Expect(response).To(BeNil())
+		Expect(restore).To(BeNil())
+
+		partial := &resourceconfigurationv1.RestorePlan{Options: createRestoreOptionsModel}
+		partial.Checks = []resourceconfigurationv1.PreflightCheck{
+			{Name: resourceconfigurationv1.PreflightCheck_Name_RecoveryRangeExists, Passed: true},
+			{Name: resourceconfigurationv1.PreflightCheck_Name_PointInTimeInRange, Passed: true},
+		}
+		_, _, operationErr = resourceConfigurationService.ExecuteRestorePlan(partial)
+		Expect(operationErr).ToNot(BeNil())
+		Expect(operationErr.Error()).To(ContainSubstring("lacks the checks target_resource_crn_valid, no_restore_in_progress"))
+		Expect(restoresCreated).To(Equal(0))
+
+		resourceConfigurationService.SetServiceURL("http://localhost:1")
+		createRestoreOptionsModel = resourceConfigurationService.NewCreateRestoreOptions("vault-a", "range-1", "in_place", CreateMockDateTime("2025-02-01T00:00:00.000Z"), crnPrefix+"bucket:target")
+		plan, operationErr = resourceConfigurationService.PlanRestore(createRestoreOptionsModel)
+		Expect(operationErr).ToNot(BeNil())
+		Expect(plan).To(BeNil())
+	})
+})
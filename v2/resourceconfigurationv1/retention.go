@@ -0,0 +1,392 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourceconfigurationv1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/ibm-cos-sdk-go-config/v2/common"
+	"github.com/IBM/ibm-cos-sdk-go-config/v2/crn"
+	"github.com/go-openapi/strfmt"
+)
+
+// RetentionRule : A requirement on the retention of RecoveryRanges.
+type RetentionRule struct {
+	// A name for the rule, recorded in the audit of the changes it causes.
+	Name string `json:"name,omitempty"`
+
+	// A glob, as in path.Match, that the name of the source bucket must match for the rule to apply, e.g. "prod-*".
+	// An empty pattern applies the rule to every RecoveryRange.
+	BucketPattern string `json:"bucket_pattern,omitempty"`
+
+	// The least number of days matching RecoveryRanges are retained.
+	MinDeleteAfterDays *int64 `json:"min_delete_after_days,omitempty"`
+
+	// The most number of days matching RecoveryRanges are retained. The retention of a RecoveryRange can only be
+	// extended, so a range retained longer is reported in the audit but not changed.
+	MaxDeleteAfterDays *int64 `json:"max_delete_after_days,omitempty"`
+}
+
+// Validate checks that the pattern is well-formed and that the bounds are valid retentions.
+func (rule *RetentionRule) Validate() error {
+	v := &validator{}
+	if _, err := path.Match(rule.BucketPattern, ""); err != nil {
+		v.errors = append(v.errors, &FieldError{Field: "bucket_pattern", Value: rule.BucketPattern, Reason: err.Error()})
+	}
+	if rule.MinDeleteAfterDays == nil && rule.MaxDeleteAfterDays == nil {
+		v.errors = append(v.errors, &FieldError{Field: "min_delete_after_days", Reason: "either min_delete_after_days or max_delete_after_days is required"})
+	}
+	v.checkRetentionDays("min_delete_after_days", rule.MinDeleteAfterDays)
+	v.checkRetentionDays("max_delete_after_days", rule.MaxDeleteAfterDays)
+	if rule.MinDeleteAfterDays != nil && rule.MaxDeleteAfterDays != nil && *rule.MinDeleteAfterDays > *rule.MaxDeleteAfterDays {
+		v.errors = append(v.errors, &FieldError{Field: "max_delete_after_days", Value: *rule.MaxDeleteAfterDays, Reason: "must not be less than min_delete_after_days"})
+	}
+	return v.err()
+}
+
+// matches reports whether the rule applies to RecoveryRanges of the bucket "bucket".
+func (rule *RetentionRule) matches(bucket string) bool {
+	if rule.BucketPattern == "" {
+		return true
+	}
+	matched, _ := path.Match(rule.BucketPattern, bucket)
+	return matched
+}
+
+// ApplyRetentionRulesOptions : The ApplyRetentionRules options.
+type ApplyRetentionRulesOptions struct {
+	// name of BackupVault whose RecoveryRanges are updated.
+	BackupVaultName *string `json:"backup_vault_name" validate:"required,ne="`
+
+	// The rules to apply. Where several rules match a RecoveryRange, the retention must satisfy all of them.
+	Rules []RetentionRule `json:"rules" validate:"required,min=1"`
+
+	// Compute the changes without applying them.
+	DryRun bool `json:"dry_run"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewApplyRetentionRulesOptions : Instantiate ApplyRetentionRulesOptions
+func (*ResourceConfigurationV1) NewApplyRetentionRulesOptions(backupVaultName string, rules []RetentionRule) *ApplyRetentionRulesOptions {
+	return &ApplyRetentionRulesOptions{
+		BackupVaultName: core.StringPtr(backupVaultName),
+		Rules:           rules,
+	}
+}
+
+// SetDryRun : Allow user to set DryRun
+func (_options *ApplyRetentionRulesOptions) SetDryRun(dryRun bool) *ApplyRetentionRulesOptions {
+	_options.DryRun = dryRun
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *ApplyRetentionRulesOptions) SetHeaders(param map[string]string) *ApplyRetentionRulesOptions {
+	options.Headers = param
+	return options
+}
+
+// Validate checks every rule.
+func (options *ApplyRetentionRulesOptions) Validate() error {
+	v := &validator{}
+	for i := range options.Rules {
+		if err := options.Rules[i].Validate(); err != nil {
+			for _, fieldError := range err.(*ValidationError).Errors {
+				fieldError.Field = fmt.Sprintf("rules[%d].%s", i, fieldError.Field)
+				v.errors = append(v.errors, fieldError)
+			}
+		}
+	}
+	return v.err()
+}
+
+// RetentionChange : The audit record of a retention change that the rules require of one RecoveryRange.
+type RetentionChange struct {
+	// The ID of the RecoveryRange.
+	RecoveryRangeID string `json:"recovery_range_id"`
+
+	// The CRN of the bucket the RecoveryRange backs up.
+	SourceResourceCrn string `json:"source_resource_crn,omitempty"`
+
+	// The names, or positions if unnamed, of the rules that determined the new retention.
+	Rules []string `json:"rules"`
+
+	// The retention before the change; absent if the RecoveryRange had none.
+	BeforeDeleteAfterDays *int64 `json:"before_delete_after_days,omitempty"`
+
+	// The retention the rules require.
+	AfterDeleteAfterDays *int64 `json:"after_delete_after_days,omitempty"`
+
+	// Whether the change was made.
+	Applied bool `json:"applied"`
+
+	// Why the change was not made: a dry run, a change that would shorten retention, a retention that is unknown, or
+	// rules that conflict.
+	SkippedReason string `json:"skipped_reason,omitempty"`
+
+	// The error returned when the change was attempted.
+	Error string `json:"error,omitempty"`
+
+	// The time the change was made.
+	AppliedAt *strfmt.DateTime `json:"applied_at,omitempty"`
+}
+
+// RetentionAudit : The outcome of applying retention rules to the RecoveryRanges of a BackupVault.
+type RetentionAudit struct {
+	// The name of the BackupVault.
+	BackupVaultName string `json:"backup_vault_name"`
+
+	// Whether the changes were only computed.
+	DryRun bool `json:"dry_run"`
+
+	// The time the rules were evaluated.
+	EvaluatedAt *strfmt.DateTime `json:"evaluated_at"`
+
+	// The number of RecoveryRanges evaluated.
+	RangesEvaluated int `json:"ranges_evaluated"`
+
+	// The RecoveryRanges whose retention the rules require to change, in the order they were listed.
+	Changes []RetentionChange `json:"changes"`
+}
+
+// Failures returns the changes that were attempted and failed.
+func (audit *RetentionAudit) Failures() []RetentionChange {
+	failures := []RetentionChange{}
+	for _, change := range audit.Changes {
+		if change.Error != "" {
+			failures = append(failures, change)
+		}
+	}
+	return failures
+}
+
+// WriteJSON writes the audit to "w" as indented JSON.
+func (audit *RetentionAudit) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(audit)
+}
+
+// WriteTable writes the audit to "w" as a table with one row per change.
+func (audit *RetentionAudit) WriteTable(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "RANGE\tSOURCE\tBEFORE\tAFTER\tAPPLIED\tRULES\tNOTE")
+	for _, change := range audit.Changes {
+		note := change.SkippedReason
+		if change.Error != "" {
+			note = change.Error
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%t\t%s\t%s\n",
+			change.RecoveryRangeID, orDash(&change.SourceResourceCrn), formatRetentionDays(change.BeforeDeleteAfterDays),
+			formatRetentionDays(change.AfterDeleteAfterDays), change.Applied, joinOrDash(change.Rules), orDash(&note))
+	}
+	return tw.Flush()
+}
+
+// ApplyRetentionRules : Bring the retention of RecoveryRanges in line with rules
+// Lists every RecoveryRange of a BackupVault, computes the retention each must have to satisfy the rules that match
+// its source bucket, and patches the ranges whose retention differs. With DryRun the changes are only computed. The
+// returned audit records the retention of every changed range before and after.
+//
+// RecoveryRanges with indefinite retention already satisfy every minimum; where a maximum applies to them, they are
+// recorded in the audit as skipped, not shortened. The retention of a
+// RecoveryRange can only be extended, so a change that would shorten retention is always skipped, as is a change to a
+// range whose current retention is unknown, and a range whose rules conflict. A change that fails is recorded in the
+// audit and does not stop the others.
+func (resourceConfiguration *ResourceConfigurationV1) ApplyRetentionRules(applyRetentionRulesOptions *ApplyRetentionRulesOptions) (result *RetentionAudit, err error) {
+	result, err = resourceConfiguration.ApplyRetentionRulesWithContext(context.Background(), applyRetentionRulesOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ApplyRetentionRulesWithContext is an alternate form of the ApplyRetentionRules method which supports a Context parameter
+func (resourceConfiguration *ResourceConfigurationV1) ApplyRetentionRulesWithContext(ctx context.Context, applyRetentionRulesOptions *ApplyRetentionRulesOptions) (result *RetentionAudit, err error) {
+	err = core.ValidateNotNil(applyRetentionRulesOptions, "applyRetentionRulesOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(applyRetentionRulesOptions, "applyRetentionRulesOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	err = applyRetentionRulesOptions.Validate()
+	if err != nil {
+		err = core.SDKErrorf(err, "", "retention-rule-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	vaultName := *applyRetentionRulesOptions.BackupVaultName
+	listRecoveryRangesOptions := resourceConfiguration.NewListRecoveryRangesOptions(vaultName)
+	listRecoveryRangesOptions.SetHeaders(applyRetentionRulesOptions.Headers)
+	pager, err := resourceConfiguration.NewRecoveryRangesPager(listRecoveryRangesOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "retention-list-recovery-ranges-error")
+		return
+	}
+	ranges, err := pager.GetAllWithContext(ctx)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "retention-list-recovery-ranges-error")
+		return
+	}
+
+	audit := &RetentionAudit{
+		BackupVaultName: vaultName,
+		DryRun:          applyRetentionRulesOptions.DryRun,
+		EvaluatedAt:     currentDateTime(),
+		RangesEvaluated: len(ranges),
+		Changes:         []RetentionChange{},
+	}
+	for i := range ranges {
+		change := evaluateRetentionRules(&ranges[i], applyRetentionRulesOptions)
+		if change == nil {
+			continue
+		}
+		if change.SkippedReason == "" && !applyRetentionRulesOptions.DryRun {
+			if ctx.Err() != nil {
+				result = audit
+				err = core.SDKErrorf(ctx.Err(), "", "retention-canceled", common.GetComponentInfo())
+				return
+			}
+			resourceConfiguration.applyRetentionChange(ctx, vaultName, change, applyRetentionRulesOptions.Headers)
+		}
+		audit.Changes = append(audit.Changes, *change)
+	}
+
+	result = audit
+	return
+}
+
+// evaluateRetentionRules returns the change the rules require of "recoveryRange", or nil if it complies.
+func evaluateRetentionRules(recoveryRange *RecoveryRange, options *ApplyRetentionRulesOptions) *RetentionChange {
+	source := core.StringNilMapper(recoveryRange.SourceResourceCrn)
+	bucket := ""
+	if parsed, err := crn.ParseBucket(source); err == nil {
+		bucket = parsed.Resource
+	}
+
+	var before *int64
+	if recoveryRange.Retention != nil {
+		before = recoveryRange.Retention.DeleteAfterDays
+	}
+	indefinite := before != nil && *before == IndefiniteRetentionDays
+
+	var lower, upper *int64
+	var lowerRule, upperRule string
+	for i := range options.Rules {
+		rule := &options.Rules[i]
+		if !rule.matches(bucket) {
+			continue
+		}
+		name := rule.Name
+		if name == "" {
+			name = "rules[" + strconv.Itoa(i) + "]"
+		}
+		if rule.MinDeleteAfterDays != nil && (lower == nil || *rule.MinDeleteAfterDays > *lower) {
+			lower, lowerRule = rule.MinDeleteAfterDays, name
+		}
+		if rule.MaxDeleteAfterDays != nil && (upper == nil || *rule.MaxDeleteAfterDays < *upper) {
+			upper, upperRule = rule.MaxDeleteAfterDays, name
+		}
+	}
+
+	change := &RetentionChange{
+		RecoveryRangeID:       core.StringNilMapper(recoveryRange.RecoveryRangeID),
+		SourceResourceCrn:     source,
+		BeforeDeleteAfterDays: before,
+	}
+	switch {
+	case lower != nil && upper != nil && *lower > *upper:
+		change.Rules = []string{lowerRule, upperRule}
+		change.SkippedReason = fmt.Sprintf("%s requires at least %d days but %s allows at most %d", lowerRule, *lower, upperRule, *upper)
+		return change
+	case lower != nil && before == nil:
+		change.Rules = []string{lowerRule}
+		change.AfterDeleteAfterDays = lower
+		change.SkippedReason = "current retention is unknown"
+		return change
+	case lower != nil && !indefinite && *before < *lower:
+		change.Rules = []string{lowerRule}
+		change.AfterDeleteAfterDays = lower
+	case upper != nil && indefinite:
+		change.Rules = []string{upperRule}
+		change.AfterDeleteAfterDays = upper
+		change.SkippedReason = "indefinite retention exceeds max; not shortened"
+		return change
+	case upper != nil && !indefinite && before != nil && *before > *upper:
+		// PatchSourceResourceRecoveryRange only extends retention.
+		change.Rules = []string{upperRule}
+		change.AfterDeleteAfterDays = upper
+		change.SkippedReason = "would shorten retention"
+		return change
+	default:
+		return nil
+	}
+	if change.SkippedReason == "" && options.DryRun {
+		change.SkippedReason = "dry run"
+	}
+	return change
+}
+
+// applyRetentionChange patches the retention of the RecoveryRange of "change" and records the outcome in it.
+func (resourceConfiguration *ResourceConfigurationV1) applyRetentionChange(ctx context.Context, vaultName string, change *RetentionChange, headers map[string]string) {
+	recoveryRangePatch := &RecoveryRangePatch{
+		Retention: &DeleteAfterDays{DeleteAfterDays: change.AfterDeleteAfterDays},
+	}
+	patch, err := recoveryRangePatch.AsPatch()
+	if err != nil {
+		change.Error = err.Error()
+		return
+	}
+	patchOptions := resourceConfiguration.NewPatchSourceResourceRecoveryRangeOptions(vaultName, change.RecoveryRangeID, patch)
+	patchOptions.SetHeaders(headers)
+	_, _, err = resourceConfiguration.PatchSourceResourceRecoveryRangeWithContext(ctx, patchOptions)
+	if err != nil {
+		change.Error = err.Error()
+		return
+	}
+	change.Applied = true
+	change.AppliedAt = currentDateTime()
+}
+
+func formatRetentionDays(days *int64) string {
+	switch {
+	case days == nil:
+		return "-"
+	case *days == IndefiniteRetentionDays:
+		return "indefinite"
+	}
+	return strconv.FormatInt(*days, 10)
+}
+
+func joinOrDash(values []string) string {
+	if len(values) == 0 {
+		return "-"
+	}
+	return strings.Join(values, ",")
+}
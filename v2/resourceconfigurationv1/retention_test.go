@@ -0,0 +1,205 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourceconfigurationv1_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/ibm-cos-sdk-go-config/v2/resourceconfigurationv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ResourceConfigurationV1 retention rules`, func() {
+	const crnPrefix = "crn:v1:bluemix:public:cloud-object-storage:global:a/3bf0d9003abfb5d29761c3e97696b71c:d6f04d83-6c4f-4a62-a165-696756d63903:"
+	var testServer *httptest.Server
+	var resourceConfigurationService *resourceconfigurationv1.ResourceConfigurationV1
+	var patches map[string]int64
+	current := map[string]int64{"r1": 30, "r2": -1, "r3": 400, "r4": 100, "r5": 10}
+	BeforeEach(func() {
+		patches = map[string]int64{}
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			switch path := req.URL.EscapedPath(); {
+			case path == "/backup_vaults/vault-a/recovery_ranges" && req.Method == "GET":
+				fmt.Fprintf(res, `{"recovery_ranges": [
+					{"recovery_range_id": "r1", "source_resource_crn": "%[1]sbucket:prod-a", "retention": {"delete_after_days": 30}},
+					{"recovery_range_id": "r2", "source_resource_crn": "%[1]sbucket:prod-b", "retention": {"delete_after_days": -1}},
+					{"recovery_range_id": "r3", "source_resource_crn": "%[1]sbucket:dev-a", "retention": {"delete_after_days": 400}},
+					{"recovery_range_id": "r4", "source_resource_crn": "%[1]sbucket:dev-b", "retention": {"delete_after_days": 100}},
+					{"recovery_range_id": "r5", "source_resource_crn": "%[1]sbucket:prod-locked", "retention": {"delete_after_days": 10}},
+					{"recovery_range_id": "r6", "source_resource_crn": "%[1]sbucket:prod-c"}
+				]}`, crnPrefix)
+			case strings.HasPrefix(path, "/backup_vaults/vault-a/recovery_ranges/") && req.Method == "PATCH":
+				Expect(req.Header.Get("Content-Type")).To(Equal("application/merge-patch+json"))
+				id := strings.TrimPrefix(path, "/backup_vaults/vault-a/recovery_ranges/")
+				if id == "r5" {
+					res.WriteHeader(409)
+					fmt.Fprint(res, `{"errors": [{"code": "conflict", "message": "range is locked"}]}`)
+					return
+				}
+				var body map[string]map[string]int64
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+				patches[id] = body["retention"]["delete_after_days"]
+				Expect(current).To(HaveKey(id))
+				Expect(patches[id]).To(BeNumerically(">", current[id]), "retention of "+id+" shortened")
+				fmt.Fprintf(res, `{"recovery_range_id": "%s", "retention": {"delete_after_days": %d}}`, id, patches[id])
+			default:
+				Fail("unexpected request " + req.Method + " " + path)
+			}
+		}))
+		var serviceErr error
+		resourceConfigurationService, serviceErr = resourceconfigurationv1.NewResourceConfigurationV1(&resourceconfigurationv1.ResourceConfigurationV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+	rules := []resourceconfigurationv1.RetentionRule{
+		{Name: "prod-minimum", BucketPattern: "prod-*", MinDeleteAfterDays: core.Int64Ptr(90)},
+		{Name: "cap", MaxDeleteAfterDays: core.Int64Ptr(365)},
+	}
+	changesByRange := func(audit *resourceconfigurationv1.RetentionAudit) map[string]resourceconfigurationv1.RetentionChange {
+		changes := map[string]resourceconfigurationv1.RetentionChange{}
+		for _, change := range audit.Changes {
+			changes[change.RecoveryRangeID] = change
+		}
+		return changes
+	}
+	It(`Invoke ApplyRetentionRules successfully`, func() {
+		audit, operationErr := resourceConfigurationService.ApplyRetentionRules(resourceConfigurationService.NewApplyRetentionRulesOptions("vault-a", rules))
+		Expect(operationErr).To(BeNil())
+		Expect(audit.DryRun).To(BeFalse())
+		Expect(audit.RangesEvaluated).To(Equal(6))
+		Expect(audit.Changes).To(HaveLen(5))
+		Expect(patches).To(Equal(map[string]int64{"r1": 90}))
+
+		changes := changesByRange(audit)
+		Expect(changes["r1"].Applied).To(BeTrue())
+		Expect(*changes["r1"].BeforeDeleteAfterDays).To(Equal(int64(30)))
+		Expect(*changes["r1"].AfterDeleteAfterDays).To(Equal(int64(90)))
+		Expect(changes["r1"].Rules).To(Equal([]string{"prod-minimum"}))
+		Expect(changes["r1"].AppliedAt).ToNot(BeNil())
+		Expect(changes["r3"].Applied).To(BeFalse())
+		Expect(changes["r3"].Rules).To(Equal([]string{"cap"}))
+		Expect(changes["r3"].SkippedReason).To(Equal("would shorten retention"))
+		Expect(changes["r2"].Applied).To(BeFalse())
+		Expect(changes["r2"].Rules).To(Equal([]string{"cap"}))
+		Expect(*changes["r2"].AfterDeleteAfterDays).To(Equal(int64(365)))
+		Expect(changes["r2"].SkippedReason).To(Equal("indefinite retention exceeds max; not shortened"))
+		Expect(changes["r6"].Applied).To(BeFalse())
+		Expect(changes["r6"].SkippedReason).To(Equal("current retention is unknown"))
+		Expect(changes["r5"].Applied).To(BeFalse())
+		Expect(changes["r5"].Error).To(ContainSubstring("range is locked"))
+		Expect(audit.Failures()).To(HaveLen(1))
+
+		var table bytes.Buffer
+		Expect(audit.WriteTable(&table)).To(Succeed())
+		lines := strings.Split(strings.TrimSpace(table.String()), "\n")
+		Expect(lines).To(HaveLen(6))
+		Expect(lines[0]).To(MatchRegexp(`^RANGE\s+SOURCE\s+BEFORE\s+AFTER\s+APPLIED\s+RULES\s+NOTE$`))
+		Expect(lines[1]).To(MatchRegexp(`^r1\s+\S+bucket:prod-a\s+30\s+90\s+true\s+prod-minimum\s+-$`))
+	})
+	It(`Invoke ApplyRetentionRules as a dry run`, func() {
+		options := resourceConfigurationService.NewApplyRetentionRulesOptions("vault-a", rules)
+		options.SetDryRun(true)
+		audit, operationErr := resourceConfigurationService.ApplyRetentionRules(options)
+		Expect(operationErr).To(BeNil())
+		Expect(audit.DryRun).To(BeTrue())
+		Expect(patches).To(BeEmpty())
+
+		changes := changesByRange(audit)
+		Expect(changes).To(HaveLen(5))
+		Expect(changes["r1"].SkippedReason).To(Equal("dry run"))
+		Expect(changes["r2"].SkippedReason).To(Equal("indefinite retention exceeds max; not shortened"))
+		Expect(changes["r3"].SkippedReason).To(Equal("would shorten retention"))
+		Expect(changes["r5"].SkippedReason).To(Equal("dry run"))
+
+		var buffer bytes.Buffer
+		Expect(audit.WriteJSON(&buffer)).To(Succeed())
+		var generic map[string]interface{}
+		Expect(json.Unmarshal(buffer.Bytes(), &generic)).To(Succeed())
+		Expect(generic["changes"].([]interface{})[0].(map[string]interface{})["before_delete_after_days"]).To(Equal(float64(30)))
+	})
+	It(`Never send a patch that shortens retention`, func() {
+		capped := []resourceconfigurationv1.RetentionRule{{Name: "cap", MaxDeleteAfterDays: core.Int64Ptr(7)}}
+		audit, operationErr := resourceConfigurationService.ApplyRetentionRules(resourceConfigurationService.NewApplyRetentionRulesOptions("vault-a", capped))
+		Expect(operationErr).To(BeNil())
+		Expect(patches).To(BeEmpty())
+		Expect(audit.Failures()).To(BeEmpty())
+		changes := changesByRange(audit)
+		Expect(changes).To(HaveLen(5))
+		for id, change := range changes {
+			Expect(change.Applied).To(BeFalse())
+			if id == "r2" {
+				Expect(change.SkippedReason).To(Equal("indefinite retention exceeds max; not shortened"))
+			} else {
+				Expect(change.SkippedReason).To(Equal("would shorten retention"))
+			}
+		}
+	})
+	It(`Invoke ApplyRetentionRules with conflicting rules`, func() {
+		conflicting := append(rules, resourceconfigurationv1.RetentionRule{Name: "dev-cap", BucketPattern: "prod-a", MaxDeleteAfterDays: core.Int64Ptr(30)})
+		audit, operationErr := resourceConfigurationService.ApplyRetentionRules(resourceConfigurationService.NewApplyRetentionRulesOptions("vault-a", conflicting).SetDryRun(true))
+		Expect(operationErr).To(BeNil())
+		change := changesByRange(audit)["r1"]
+		Expect(change.Rules).To(Equal([]string{"prod-minimum", "dev-cap"}))
+		Expect(change.SkippedReason).To(Equal("prod-minimum requires at least 90 days but dev-cap allows at most 30"))
+	})
+	It(`Invoke ApplyRetentionRules with error`, func() {
+		audit, operationErr := resourceConfigurationService.ApplyRetentionRules(nil)
+		Expect(operationErr).ToNot(BeNil())
+		Expect(audit).To(BeNil())
+
+		audit, operationErr = resourceConfigurationService.ApplyRetentionRules(resourceConfigurationService.NewApplyRetentionRulesOptions("vault-a", nil))
+		Expect(operationErr).ToNot(BeNil())
+		Expect(audit).To(BeNil())
+
+		invalid := []resourceconfigurationv1.RetentionRule{
+			{BucketPattern: "[", MinDeleteAfterDays: core.Int64Ptr(0)},
+			{MinDeleteAfterDays: core.Int64Ptr(30), MaxDeleteAfterDays: core.Int64Ptr(7)},
+			{Name: "empty"},
+		}
+		audit, operationErr = resourceConfigurationService.ApplyRetentionRules(resourceConfigurationService.NewApplyRetentionRulesOptions("vault-a", invalid))
+		Expect(audit).To(BeNil())
+		var validationErr *resourceconfigurationv1.ValidationError
+		Expect(errors.As(operationErr, &validationErr)).To(BeTrue())
+		fields := []string{}
+		for _, fieldError := range validationErr.Errors {
+			fields = append(fields, fieldError.Field)
+		}
+		Expect(fields).To(Equal([]string{
+			"rules[0].bucket_pattern",
+			"rules[0].min_delete_after_days",
+			"rules[1].max_delete_after_days",
+			"rules[2].min_delete_after_days",
+		}))
+		Expect(patches).To(BeEmpty())
+	})
+})
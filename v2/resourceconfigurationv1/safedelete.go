@@ -0,0 +1,304 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourceconfigurationv1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/ibm-cos-sdk-go-config/v2/common"
+	"github.com/IBM/ibm-cos-sdk-go-config/v2/crn"
+)
+
+// GetBackupVaultDependenciesOptions : The GetBackupVaultDependencies options.
+type GetBackupVaultDependenciesOptions struct {
+	// Name of the backup-vault to inspect.
+	BackupVaultName *string `json:"backup_vault_name" validate:"required,ne="`
+
+	// The buckets whose backup policies are searched for policies that target the backup vault. Policies are not
+	// listed per vault, so policies of other buckets are not found.
+	Buckets []string `json:"buckets,omitempty" validate:"dive,required"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewGetBackupVaultDependenciesOptions : Instantiate GetBackupVaultDependenciesOptions
+func (*ResourceConfigurationV1) NewGetBackupVaultDependenciesOptions(backupVaultName string) *GetBackupVaultDependenciesOptions {
+	return &GetBackupVaultDependenciesOptions{
+		BackupVaultName: core.StringPtr(backupVaultName),
+	}
+}
+
+// SetBuckets : Allow user to set Buckets
+func (_options *GetBackupVaultDependenciesOptions) SetBuckets(buckets []string) *GetBackupVaultDependenciesOptions {
+	_options.Buckets = buckets
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *GetBackupVaultDependenciesOptions) SetHeaders(param map[string]string) *GetBackupVaultDependenciesOptions {
+	options.Headers = param
+	return options
+}
+
+// BackupVaultDependencies : What deleting a backup vault would destroy or break.
+type BackupVaultDependencies struct {
+	// The name of the backup vault.
+	BackupVaultName string `json:"backup_vault_name"`
+
+	// The RecoveryRanges stored in the backup vault; deleting it destroys them.
+	RecoveryRanges []RecoveryRange `json:"recovery_ranges"`
+
+	// The restores from the backup vault that are `initializing` or `running`.
+	RestoresInProgress []Restore `json:"restores_in_progress"`
+
+	// The backup policies of the searched buckets that target the backup vault.
+	BackupPolicies []DependentBackupPolicy `json:"backup_policies"`
+}
+
+// DependentBackupPolicy : A backup policy that targets a backup vault.
+type DependentBackupPolicy struct {
+	// The bucket the policy belongs to.
+	Bucket string `json:"bucket"`
+
+	// The policy.
+	Policy *BackupPolicy `json:"policy"`
+}
+
+// Empty reports whether nothing depends on the backup vault.
+func (dependencies *BackupVaultDependencies) Empty() bool {
+	return len(dependencies.RecoveryRanges) == 0 && len(dependencies.RestoresInProgress) == 0 && len(dependencies.BackupPolicies) == 0
+}
+
+// String summarizes the dependencies.
+func (dependencies *BackupVaultDependencies) String() string {
+	return fmt.Sprintf("%d recovery ranges, %d restores in progress and %d backup policies", len(dependencies.RecoveryRanges),
+		len(dependencies.RestoresInProgress), len(dependencies.BackupPolicies))
+}
+
+// GetBackupVaultDependencies : List what depends on a backup vault
+// Lists the RecoveryRanges stored in a backup vault, the restores from it that are in progress, and the backup
+// policies of the given buckets that target it.
+func (resourceConfiguration *ResourceConfigurationV1) GetBackupVaultDependencies(getBackupVaultDependenciesOptions *GetBackupVaultDependenciesOptions) (result *BackupVaultDependencies, err error) {
+	result, err = resourceConfiguration.GetBackupVaultDependenciesWithContext(context.Background(), getBackupVaultDependenciesOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetBackupVaultDependenciesWithContext is an alternate form of the GetBackupVaultDependencies method which supports a Context parameter
+func (resourceConfiguration *ResourceConfigurationV1) GetBackupVaultDependenciesWithContext(ctx context.Context, getBackupVaultDependenciesOptions *GetBackupVaultDependenciesOptions) (result *BackupVaultDependencies, err error) {
+	err = core.ValidateNotNil(getBackupVaultDependenciesOptions, "getBackupVaultDependenciesOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(getBackupVaultDependenciesOptions, "getBackupVaultDependenciesOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	vaultName := *getBackupVaultDependenciesOptions.BackupVaultName
+	headers := getBackupVaultDependenciesOptions.Headers
+	dependencies := &BackupVaultDependencies{
+		BackupVaultName:    vaultName,
+		RecoveryRanges:     []RecoveryRange{},
+		RestoresInProgress: []Restore{},
+		BackupPolicies:     []DependentBackupPolicy{},
+	}
+
+	listRecoveryRangesOptions := resourceConfiguration.NewListRecoveryRangesOptions(vaultName)
+	listRecoveryRangesOptions.SetHeaders(headers)
+	rangesPager, err := resourceConfiguration.NewRecoveryRangesPager(listRecoveryRangesOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "dependencies-list-recovery-ranges-error")
+		return
+	}
+	ranges, err := rangesPager.GetAllWithContext(ctx)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "dependencies-list-recovery-ranges-error")
+		return
+	}
+	dependencies.RecoveryRanges = append(dependencies.RecoveryRanges, ranges...)
+
+	listRestoresOptions := resourceConfiguration.NewListRestoresOptions(vaultName)
+	listRestoresOptions.SetHeaders(headers)
+	restoresPager, err := resourceConfiguration.NewRestoresPager(listRestoresOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "dependencies-list-restores-error")
+		return
+	}
+	restores, err := restoresPager.GetAllWithContext(ctx)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "dependencies-list-restores-error")
+		return
+	}
+	for _, restore := range restores {
+		switch core.StringNilMapper(restore.RestoreStatus) {
+		case Restore_RestoreStatus_Initializing, Restore_RestoreStatus_Running:
+			dependencies.RestoresInProgress = append(dependencies.RestoresInProgress, restore)
+		}
+	}
+
+	for _, bucket := range getBackupVaultDependenciesOptions.Buckets {
+		listBackupPoliciesOptions := resourceConfiguration.NewListBackupPoliciesOptions(bucket)
+		listBackupPoliciesOptions.SetHeaders(headers)
+		var policies *BackupPolicyCollection
+		policies, _, err = resourceConfiguration.ListBackupPoliciesWithContext(ctx, listBackupPoliciesOptions)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "dependencies-list-backup-policies-error")
+			return
+		}
+		for i := range policies.BackupPolicies {
+			policy := &policies.BackupPolicies[i]
+			if target, parseErr := crn.ParseBackupVault(core.StringNilMapper(policy.TargetBackupVaultCrn)); parseErr == nil && target.Resource == vaultName {
+				dependencies.BackupPolicies = append(dependencies.BackupPolicies, DependentBackupPolicy{Bucket: bucket, Policy: policy})
+			}
+		}
+	}
+
+	result = dependencies
+	return
+}
+
+// SafeDeleteBackupVaultOptions : The SafeDeleteBackupVault options.
+type SafeDeleteBackupVaultOptions struct {
+	// Name of the backup-vault to delete.
+	BackupVaultName *string `json:"backup_vault_name" validate:"required,ne="`
+
+	// The buckets whose backup policies are searched for policies that target the backup vault.
+	Buckets []string `json:"buckets,omitempty" validate:"dive,required"`
+
+	// Delete the backup policies that target the backup vault before the vault. Requires ConfirmationToken. A vault
+	// that contains RecoveryRanges is still not deleted.
+	Cascade bool `json:"cascade,omitempty"`
+
+	// Must equal the name of the backup vault when Cascade is set.
+	ConfirmationToken *string `json:"confirmation_token,omitempty"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewSafeDeleteBackupVaultOptions : Instantiate SafeDeleteBackupVaultOptions
+func (*ResourceConfigurationV1) NewSafeDeleteBackupVaultOptions(backupVaultName string) *SafeDeleteBackupVaultOptions {
+	return &SafeDeleteBackupVaultOptions{
+		BackupVaultName: core.StringPtr(backupVaultName),
+	}
+}
+
+// SetBuckets : Allow user to set Buckets
+func (_options *SafeDeleteBackupVaultOptions) SetBuckets(buckets []string) *SafeDeleteBackupVaultOptions {
+	_options.Buckets = buckets
+	return _options
+}
+
+// SetCascade : Allow user to set Cascade, confirmed by "confirmationToken", which must equal the backup vault name
+func (_options *SafeDeleteBackupVaultOptions) SetCascade(confirmationToken string) *SafeDeleteBackupVaultOptions {
+	_options.Cascade = true
+	_options.ConfirmationToken = core.StringPtr(confirmationToken)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *SafeDeleteBackupVaultOptions) SetHeaders(param map[string]string) *SafeDeleteBackupVaultOptions {
+	options.Headers = param
+	return options
+}
+
+// SafeDeleteBackupVault : Delete a backup vault only if nothing depends on it
+// Looks up the dependencies of the backup vault as GetBackupVaultDependencies does, and deletes the vault only if
+// there are none. Otherwise the dependencies are returned with an error and nothing is deleted.
+//
+// With Cascade, the backup policies that target the vault are deleted first, and then the vault. Cascade requires a
+// ConfirmationToken equal to the vault name. It still refuses, before deleting anything, to delete a vault that
+// restores are in progress from or that contains RecoveryRanges, since DeleteBackupVault rejects such a vault and
+// RecoveryRanges cannot be deleted.
+func (resourceConfiguration *ResourceConfigurationV1) SafeDeleteBackupVault(safeDeleteBackupVaultOptions *SafeDeleteBackupVaultOptions) (result *BackupVaultDependencies, response *core.DetailedResponse, err error) {
+	result, response, err = resourceConfiguration.SafeDeleteBackupVaultWithContext(context.Background(), safeDeleteBackupVaultOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// SafeDeleteBackupVaultWithContext is an alternate form of the SafeDeleteBackupVault method which supports a Context parameter
+func (resourceConfiguration *ResourceConfigurationV1) SafeDeleteBackupVaultWithContext(ctx context.Context, safeDeleteBackupVaultOptions *SafeDeleteBackupVaultOptions) (result *BackupVaultDependencies, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(safeDeleteBackupVaultOptions, "safeDeleteBackupVaultOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(safeDeleteBackupVaultOptions, "safeDeleteBackupVaultOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	vaultName := *safeDeleteBackupVaultOptions.BackupVaultName
+	cascade := safeDeleteBackupVaultOptions.Cascade
+	if cascade && core.StringNilMapper(safeDeleteBackupVaultOptions.ConfirmationToken) != vaultName {
+		err = core.SDKErrorf(nil, fmt.Sprintf("the confirmation token must be the backup vault name %q to cascade the deletion", vaultName),
+			"confirmation-token-mismatch", common.GetComponentInfo())
+		return
+	}
+
+	getDependenciesOptions := resourceConfiguration.NewGetBackupVaultDependenciesOptions(vaultName)
+	getDependenciesOptions.SetBuckets(safeDeleteBackupVaultOptions.Buckets)
+	getDependenciesOptions.SetHeaders(safeDeleteBackupVaultOptions.Headers)
+	result, err = resourceConfiguration.GetBackupVaultDependenciesWithContext(ctx, getDependenciesOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "safe-delete-dependencies-error")
+		return
+	}
+	switch {
+	case len(result.RestoresInProgress) > 0:
+		ids := []string{}
+		for _, restore := range result.RestoresInProgress {
+			ids = append(ids, core.StringNilMapper(restore.RestoreID))
+		}
+		err = core.SDKErrorf(nil, fmt.Sprintf("backup vault %s has restores in progress: %s", vaultName, strings.Join(ids, ", ")),
+			"backup-vault-restore-in-progress", common.GetComponentInfo())
+		return
+	case len(result.RecoveryRanges) > 0 && cascade:
+		err = core.SDKErrorf(nil, fmt.Sprintf("backup vault %s has %d recovery ranges; a backup vault that contains recovery ranges cannot be deleted",
+			vaultName, len(result.RecoveryRanges)), "backup-vault-has-recovery-ranges", common.GetComponentInfo())
+		return
+	case !cascade && !result.Empty():
+		err = core.SDKErrorf(nil, fmt.Sprintf("backup vault %s has %s; cascade the deletion to delete them", vaultName, result.String()),
+			"backup-vault-has-dependencies", common.GetComponentInfo())
+		return
+	}
+
+	for _, dependent := range result.BackupPolicies {
+		deleteBackupPolicyOptions := resourceConfiguration.NewDeleteBackupPolicyOptions(dependent.Bucket, *dependent.Policy.PolicyID)
+		deleteBackupPolicyOptions.SetHeaders(safeDeleteBackupVaultOptions.Headers)
+		response, err = resourceConfiguration.DeleteBackupPolicyWithContext(ctx, deleteBackupPolicyOptions)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "safe-delete-backup-policy-error")
+			return
+		}
+		core.GetLogger().Info("Deleted backup policy %s of bucket %s before deleting backup vault %s\n",
+			*dependent.Policy.PolicyID, dependent.Bucket, vaultName)
+	}
+
+	deleteBackupVaultOptions := resourceConfiguration.NewDeleteBackupVaultOptions(vaultName)
+	deleteBackupVaultOptions.SetHeaders(safeDeleteBackupVaultOptions.Headers)
+	response, err = resourceConfiguration.DeleteBackupVaultWithContext(ctx, deleteBackupVaultOptions)
+	err = core.RepurposeSDKProblem(err, "safe-delete-backup-vault-error")
+	return
+}
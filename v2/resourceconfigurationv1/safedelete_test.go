@@ -0,0 +1,171 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourceconfigurationv1_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/ibm-cos-sdk-go-config/v2/resourceconfigurationv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ResourceConfigurationV1 safe backup vault deletion`, func() {
+	const vaultCrnPrefix = "crn:v1:bluemix:public:cloud-object-storage:global:a/3bf0d9003abfb5d29761c3e97696b71c:d6f04d83-6c4f-4a62-a165-696756d63903:backup-vault:"
+	var testServer *httptest.Server
+	var resourceConfigurationService *resourceconfigurationv1.ResourceConfigurationV1
+	var ranges string
+	var restoreStatus string
+	var deleted []string
+	BeforeEach(func() {
+		ranges = `[]`
+		restoreStatus = "complete"
+		deleted = []string{}
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			if req.Method == "DELETE" {
+				deleted = append(deleted, req.URL.EscapedPath())
+				res.WriteHeader(204)
+				return
+			}
+			Expect(req.Method).To(Equal("GET"))
+			switch path := req.URL.EscapedPath(); path {
+			case "/backup_vaults/my-vault/recovery_ranges":
+				fmt.Fprintf(res, `{"recovery_ranges": %s}`, ranges)
+			case "/backup_vaults/my-vault/restores":
+				fmt.Fprintf(res, `{"restores": [{"restore_id": "restore-1", "restore_status": "%s"}]}`, restoreStatus)
+			case "/buckets/bucket-1/backup_policies":
+				fmt.Fprintf(res, `{"backup_policies": [
+					{"policy_id": "p1", "target_backup_vault_crn": "%[1]smy-vault"},
+					{"policy_id": "p2", "target_backup_vault_crn": "%[1]sother-vault"}
+				]}`, vaultCrnPrefix)
+			case "/buckets/bucket-2/backup_policies":
+				fmt.Fprintf(res, `{"backup_policies": [{"policy_id": "p3", "target_backup_vault_crn": "%smy-vault"}]}`, vaultCrnPrefix)
+			default:
+				Fail("unexpected request " + path)
+			}
+		}))
+		var serviceErr error
+		resourceConfigurationService, serviceErr = resourceconfigurationv1.NewResourceConfigurationV1(&resourceconfigurationv1.ResourceConfigurationV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+	It(`Invoke GetBackupVaultDependencies successfully`, func() {
+		ranges = `[{"recovery_range_id": "r1"}]`
+		restoreStatus = "running"
+		getBackupVaultDependenciesOptionsModel := resourceConfigurationService.NewGetBackupVaultDependenciesOptions("my-vault")
+		getBackupVaultDependenciesOptionsModel.SetBuckets([]string{"bucket-1", "bucket-2"})
+		dependencies, operationErr := resourceConfigurationService.GetBackupVaultDependencies(getBackupVaultDependenciesOptionsModel)
+		Expect(operationErr).To(BeNil())
+		Expect(dependencies.Empty()).To(BeFalse())
+		Expect(dependencies.RecoveryRanges).To(HaveLen(1))
+		Expect(dependencies.RestoresInProgress).To(HaveLen(1))
+		Expect(dependencies.BackupPolicies).To(HaveLen(2))
+		Expect(dependencies.BackupPolicies[0].Bucket).To(Equal("bucket-1"))
+		Expect(*dependencies.BackupPolicies[0].Policy.PolicyID).To(Equal("p1"))
+		Expect(*dependencies.BackupPolicies[1].Policy.PolicyID).To(Equal("p3"))
+		Expect(dependencies.String()).To(Equal("1 recovery ranges, 1 restores in progress and 2 backup policies"))
+	})
+	It(`Invoke SafeDeleteBackupVault without dependencies`, func() {
+		dependencies, response, operationErr := resourceConfigurationService.SafeDeleteBackupVault(resourceConfigurationService.NewSafeDeleteBackupVaultOptions("my-vault"))
+		Expect(operationErr).To(BeNil())
+		Expect(response.StatusCode).To(Equal(204))
+		Expect(dependencies.Empty()).To(BeTrue())
+		Expect(deleted).To(Equal([]string{"/backup_vaults/my-vault"}))
+	})
+	It(`Refuse to delete a backup vault with dependencies`, func() {
+		safeDeleteBackupVaultOptionsModel := resourceConfigurationService.NewSafeDeleteBackupVaultOptions("my-vault")
+		safeDeleteBackupVaultOptionsModel.SetBuckets([]string{"bucket-1"})
+		dependencies, _, operationErr := resourceConfigurationService.SafeDeleteBackupVault(safeDeleteBackupVaultOptionsModel)
+		Expect(operationErr).ToNot(BeNil())
+		Expect(operationErr.Error()).To(ContainSubstring("has 0 recovery ranges, 0 restores in progress and 1 backup policies"))
+		Expect(dependencies.BackupPolicies).To(HaveLen(1))
+		Expect(deleted).To(BeEmpty())
+
+		ranges = `[{"recovery_range_id": "r1"}]`
+		_, _, operationErr = resourceConfigurationService.SafeDeleteBackupVault(resourceConfigurationService.NewSafeDeleteBackupVaultOptions("my-vault"))
+		Expect(operationErr).ToNot(BeNil())
+		Expect(deleted).To(BeEmpty())
+	})
+	It(`Invoke SafeDeleteBackupVault with cascade`, func() {
+		safeDeleteBackupVaultOptionsModel := resourceConfigurationService.NewSafeDeleteBackupVaultOptions("my-vault")
+		safeDeleteBackupVaultOptionsModel.SetBuckets([]string{"bucket-1", "bucket-2"})
+		safeDeleteBackupVaultOptionsModel.SetCascade("other-vault")
+		_, _, operationErr := resourceConfigurationService.SafeDeleteBackupVault(safeDeleteBackupVaultOptionsModel)
+		Expect(operationErr).ToNot(BeNil())
+		Expect(operationErr.Error()).To(ContainSubstring("confirmation token"))
+		Expect(deleted).To(BeEmpty())
+
+		safeDeleteBackupVaultOptionsModel.SetCascade("my-vault")
+		dependencies, response, operationErr := resourceConfigurationService.SafeDeleteBackupVault(safeDeleteBackupVaultOptionsModel)
+		Expect(operationErr).To(BeNil())
+		Expect(response.StatusCode).To(Equal(204))
+		Expect(dependencies.BackupPolicies).To(HaveLen(2))
+		Expect(deleted).To(Equal([]string{
+			"/buckets/bucket-1/backup_policies/p1",
+			"/buckets/bucket-2/backup_policies/p3",
+			"/backup_vaults/my-vault",
+		}))
+	})
+	It(`Refuse to cascade when the backup vault contains recovery ranges`, func() {
+		ranges = `[{"recovery_range_id": "r1"}]`
+		safeDeleteBackupVaultOptionsModel := resourceConfigurationService.NewSafeDeleteBackupVaultOptions("my-vault")
+		safeDeleteBackupVaultOptionsModel.SetBuckets([]string{"bucket-1", "bucket-2"})
+		safeDeleteBackupVaultOptionsModel.SetCascade("my-vault")
+		dependencies, response, operationErr := resourceConfigurationService.SafeDeleteBackupVault(safeDeleteBackupVaultOptionsModel)
+		Expect(operationErr).ToNot(BeNil())
+		Expect(operationErr.Error()).To(ContainSubstring("has 1 recovery ranges; a backup vault that contains recovery ranges cannot be deleted"))
+		Expect(response).To(BeNil())
+		Expect(dependencies.RecoveryRanges).To(HaveLen(1))
+		Expect(dependencies.BackupPolicies).To(HaveLen(2))
+		Expect(deleted).To(BeEmpty())
+	})
+	It(`Refuse to cascade while a restore is in progress`, func() {
+		restoreStatus = "initializing"
+		safeDeleteBackupVaultOptionsModel := resourceConfigurationService.NewSafeDeleteBackupVaultOptions("my-vault")
+		safeDeleteBackupVaultOptionsModel.SetBuckets([]string{"bucket-1"})
+		safeDeleteBackupVaultOptionsModel.SetCascade("my-vault")
+		_, _, operationErr := resourceConfigurationService.SafeDeleteBackupVault(safeDeleteBackupVaultOptionsModel)
+		Expect(operationErr).ToNot(BeNil())
+		Expect(operationErr.Error()).To(ContainSubstring("restores in progress: restore-1"))
+		Expect(deleted).To(BeEmpty())
+	})
+	It(`Invoke SafeDeleteBackupVault with error`, func() {
+		dependencies, response, operationErr := resourceConfigurationService.SafeDeleteBackupVault(nil)
+		Expect(operationErr).ToNot(BeNil())
+		Expect(response).To(BeNil())
+		Expect(dependencies).To(BeNil())
+
+		dependencies, response, operationErr = resourceConfigurationService.SafeDeleteBackupVault(resourceConfigurationService.NewSafeDeleteBackupVaultOptions(""))
+		Expect(operationErr).ToNot(BeNil())
+		Expect(response).To(BeNil())
+		Expect(dependencies).To(BeNil())
+
+		_, operationErr = resourceConfigurationService.GetBackupVaultDependencies(nil)
+		Expect(operationErr).ToNot(BeNil())
+	})
+})
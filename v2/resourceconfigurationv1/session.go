@@ -0,0 +1,131 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourceconfigurationv1
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/ibm-cos-sdk-go-config/v2/common"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/credentials"
+	"github.com/IBM/ibm-cos-sdk-go/aws/session"
+)
+
+// AUTHTYPE_COS_CREDENTIALS is the authentication type of CredentialsAuthenticator.
+const AUTHTYPE_COS_CREDENTIALS = "cosCredentials"
+
+// CredentialsAuthenticator : Authenticates requests with the IBM IAM token of ibm-cos-sdk-go credentials,
+// such as those created with ibmiam.NewStaticCredentials, so that the S3 client and the Resource Configuration
+// client share one token.
+type CredentialsAuthenticator struct {
+	// The IBM IAM credentials of an ibm-cos-sdk-go session or aws.Config.
+	Credentials *credentials.Credentials
+}
+
+// NewCredentialsAuthenticator : Instantiate CredentialsAuthenticator
+func NewCredentialsAuthenticator(creds *credentials.Credentials) (*CredentialsAuthenticator, error) {
+	authenticator := &CredentialsAuthenticator{
+		Credentials: creds,
+	}
+	if err := authenticator.Validate(); err != nil {
+		return nil, err
+	}
+	return authenticator, nil
+}
+
+// AuthenticationType returns the authentication type of this authenticator.
+func (*CredentialsAuthenticator) AuthenticationType() string {
+	return AUTHTYPE_COS_CREDENTIALS
+}
+
+// Validate checks that the authenticator has credentials.
+func (authenticator *CredentialsAuthenticator) Validate() error {
+	if authenticator.Credentials == nil {
+		return core.SDKErrorf(nil, "the credentials are required", "missing-credentials", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// Authenticate adds the IBM IAM access token of the credentials to the Authorization header of "request".
+func (authenticator *CredentialsAuthenticator) Authenticate(request *http.Request) error {
+	value, err := authenticator.Credentials.GetWithContext(request.Context())
+	if err != nil {
+		return core.SDKErrorf(err, "", "credentials-retrieve-error", common.GetComponentInfo())
+	}
+	if value.AccessToken == "" {
+		return core.SDKErrorf(nil, fmt.Sprintf("credentials from provider %q have no IBM IAM token; HMAC credentials cannot be used with the Resource Configuration API", value.ProviderName), "credentials-no-token", common.GetComponentInfo())
+	}
+	tokenType := value.TokenType
+	if tokenType == "" {
+		tokenType = "Bearer"
+	}
+	request.Header.Set("Authorization", tokenType+" "+value.AccessToken)
+	return nil
+}
+
+// NewResourceConfigurationV1FromSession : constructs an instance of ResourceConfigurationV1 that shares the IBM IAM
+// credentials and region of an ibm-cos-sdk-go session.
+// See NewResourceConfigurationV1FromConfig for how "options" is used.
+func NewResourceConfigurationV1FromSession(sess *session.Session, options *ResourceConfigurationV1Options) (resourceConfiguration *ResourceConfigurationV1, err error) {
+	err = core.ValidateNotNil(sess, "sess cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	resourceConfiguration, err = NewResourceConfigurationV1FromConfig(sess.Config, options)
+	err = core.RepurposeSDKProblem(err, "new-client-error")
+	return
+}
+
+// NewResourceConfigurationV1FromConfig : constructs an instance of ResourceConfigurationV1 that shares the IBM IAM
+// credentials and region of an ibm-cos-sdk-go aws.Config.
+//
+// "options" may be nil. Its Authenticator must not be set. When none of its URL, Region and EndpointType are set,
+// the regional endpoint of the config's region is used if there is one, and the global endpoint otherwise. Regions
+// such as "us-geo" that only exist for object storage therefore use the global endpoint.
+func NewResourceConfigurationV1FromConfig(config *aws.Config, options *ResourceConfigurationV1Options) (resourceConfiguration *ResourceConfigurationV1, err error) {
+	err = core.ValidateNotNil(config, "config cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+
+	serviceOptions := ResourceConfigurationV1Options{}
+	if options != nil {
+		serviceOptions = *options
+	}
+	if serviceOptions.Authenticator != nil {
+		err = core.SDKErrorf(nil, "the Authenticator option cannot be combined with ibm-cos-sdk-go credentials", "authenticator-conflict", common.GetComponentInfo())
+		return
+	}
+	serviceOptions.Authenticator, err = NewCredentialsAuthenticator(config.Credentials)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "credentials-error")
+		return
+	}
+
+	region := aws.StringValue(config.Region)
+	if serviceOptions.URL == "" && serviceOptions.Region == "" && isRegion(region) {
+		serviceOptions.Region = region
+	}
+
+	resourceConfiguration, err = NewResourceConfigurationV1(&serviceOptions)
+	err = core.RepurposeSDKProblem(err, "new-client-error")
+	return
+}
@@ -0,0 +1,117 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourceconfigurationv1_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/ibm-cos-sdk-go-config/v2/resourceconfigurationv1"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/credentials"
+	"github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam"
+	"github.com/IBM/ibm-cos-sdk-go/aws/session"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ResourceConfigurationV1 from ibm-cos-sdk-go`, func() {
+	var testServer *httptest.Server
+	var tokenRequests int32
+	BeforeEach(func() {
+		atomic.StoreInt32(&tokenRequests, 0)
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			if req.URL.Path == "/identity/token" {
+				atomic.AddInt32(&tokenRequests, 1)
+				Expect(req.FormValue("apikey")).To(Equal("testApiKey"))
+				fmt.Fprintf(res, `{"access_token": "testToken", "refresh_token": "refresh", "token_type": "Bearer", "expires_in": 3600, "expiration": %d}`, time.Now().Add(time.Hour).Unix())
+				return
+			}
+			Expect(req.URL.EscapedPath()).To(Equal("/backup_vaults/testString"))
+			Expect(req.Header.Get("Authorization")).To(Equal("Bearer testToken"))
+			fmt.Fprint(res, `{"backup_vault_name": "testString"}`)
+		}))
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+	It(`Invoke NewResourceConfigurationV1FromSession successfully`, func() {
+		conf := aws.NewConfig().
+			WithRegion("us-south").
+			WithCredentials(ibmiam.NewStaticCredentials(aws.NewConfig(), testServer.URL+"/identity/token", "testApiKey", "testInstance"))
+		sess, err := session.NewSession(conf)
+		Expect(err).To(BeNil())
+
+		resourceConfigurationService, err := resourceconfigurationv1.NewResourceConfigurationV1FromSession(sess, nil)
+		Expect(err).To(BeNil())
+		Expect(resourceConfigurationService.GetServiceURL()).To(Equal("https://config.us-south.cloud-object-storage.cloud.ibm.com/v1"))
+		Expect(resourceConfigurationService.Service.Options.Authenticator.AuthenticationType()).To(Equal(resourceconfigurationv1.AUTHTYPE_COS_CREDENTIALS))
+
+		resourceConfigurationService, err = resourceconfigurationv1.NewResourceConfigurationV1FromSession(sess, &resourceconfigurationv1.ResourceConfigurationV1Options{
+			URL: testServer.URL,
+		})
+		Expect(err).To(BeNil())
+		for i := 0; i < 2; i++ {
+			result, _, operationErr := resourceConfigurationService.GetBackupVault(resourceConfigurationService.NewGetBackupVaultOptions("testString"))
+			Expect(operationErr).To(BeNil())
+			Expect(*result.BackupVaultName).To(Equal("testString"))
+		}
+		Expect(atomic.LoadInt32(&tokenRequests)).To(Equal(int32(1)))
+	})
+	It(`Invoke NewResourceConfigurationV1FromConfig with a cross-region location`, func() {
+		conf := aws.NewConfig().
+			WithRegion("us-geo").
+			WithCredentials(ibmiam.NewStaticCredentials(aws.NewConfig(), testServer.URL+"/identity/token", "testApiKey", "testInstance"))
+		resourceConfigurationService, err := resourceconfigurationv1.NewResourceConfigurationV1FromConfig(conf, &resourceconfigurationv1.ResourceConfigurationV1Options{
+			EndpointType: resourceconfigurationv1.EndpointTypePrivate,
+		})
+		Expect(err).To(BeNil())
+		Expect(resourceConfigurationService.GetServiceURL()).To(Equal("https://config.private.cloud-object-storage.cloud.ibm.com/v1"))
+	})
+	It(`Invoke NewResourceConfigurationV1FromConfig with error`, func() {
+		resourceConfigurationService, err := resourceconfigurationv1.NewResourceConfigurationV1FromConfig(nil, nil)
+		Expect(err).ToNot(BeNil())
+		Expect(resourceConfigurationService).To(BeNil())
+
+		resourceConfigurationService, err = resourceconfigurationv1.NewResourceConfigurationV1FromConfig(aws.NewConfig(), nil)
+		Expect(err).ToNot(BeNil())
+		Expect(resourceConfigurationService).To(BeNil())
+
+		conf := aws.NewConfig().WithCredentials(credentials.NewStaticCredentials("accessKey", "secretKey", ""))
+		resourceConfigurationService, err = resourceconfigurationv1.NewResourceConfigurationV1FromConfig(conf, &resourceconfigurationv1.ResourceConfigurationV1Options{
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("cannot be combined"))
+
+		// HMAC credentials carry no IAM token.
+		resourceConfigurationService, err = resourceconfigurationv1.NewResourceConfigurationV1FromConfig(conf, &resourceconfigurationv1.ResourceConfigurationV1Options{
+			URL: testServer.URL,
+		})
+		Expect(err).To(BeNil())
+		_, _, operationErr := resourceConfigurationService.GetBackupVault(resourceConfigurationService.NewGetBackupVaultOptions("testString"))
+		Expect(operationErr).ToNot(BeNil())
+		Expect(operationErr.Error()).To(ContainSubstring("HMAC credentials cannot be used"))
+	})
+})
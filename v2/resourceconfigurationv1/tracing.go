@@ -0,0 +1,154 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourceconfigurationv1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"strconv"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/ibm-cos-sdk-go-config/v2/common"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracerName is the instrumentation scope of the tracer that starts the spans of operations.
+const TracerName = "github.com/IBM/ibm-cos-sdk-go-config/v2/resourceconfigurationv1"
+
+// Attribute keys of the spans of operations, besides the OpenTelemetry HTTP client attributes "http.request.method",
+// "url.full", "http.response.status_code" and "error.type".
+const (
+	SpanAttribute_Bucket          = attribute.Key("cos_config.bucket")
+	SpanAttribute_BackupVaultName = attribute.Key("cos_config.backup_vault")
+	SpanAttribute_PolicyID        = attribute.Key("cos_config.backup_policy_id")
+	SpanAttribute_RestoreID       = attribute.Key("cos_config.restore_id")
+	SpanAttribute_RecoveryRangeID = attribute.Key("cos_config.recovery_range_id")
+	SpanAttribute_RequestID       = attribute.Key("cos_config.request_id")
+	SpanAttribute_ProblemID       = attribute.Key("cos_config.problem_id")
+)
+
+// requestIDHeader is the response header that identifies a request to IBM support.
+const requestIDHeader = "X-Request-Id"
+
+// spanOptionFields maps the options fields recorded on the spans of operations to their attribute keys.
+var spanOptionFields = []struct {
+	field string
+	key   attribute.Key
+}{
+	{"Bucket", SpanAttribute_Bucket},
+	{"BackupVaultName", SpanAttribute_BackupVaultName},
+	{"PolicyID", SpanAttribute_PolicyID},
+	{"RestoreID", SpanAttribute_RestoreID},
+	{"RecoveryRangeID", SpanAttribute_RecoveryRangeID},
+}
+
+// SetTracerProvider sets the provider of the tracer that starts a span for every operation; nil, the default, uses
+// the global tracer provider of OpenTelemetry, which records nothing until one is registered with
+// otel.SetTracerProvider.
+//
+// Each span is named after its operation, such as "CreateRestore", and has the bucket, backup vault and resource ID
+// of the options, the HTTP method, URL and status code and the request ID of the response. Errors are recorded as
+// span events.
+func (resourceConfiguration *ResourceConfigurationV1) SetTracerProvider(provider trace.TracerProvider) {
+	resourceConfiguration.tracerProvider = provider
+}
+
+// SetTextMapPropagator sets the propagator that adds the trace context of an operation to its request headers; nil,
+// the default, sends W3C Trace Context "traceparent" and "tracestate" headers.
+func (resourceConfiguration *ResourceConfigurationV1) SetTextMapPropagator(propagator propagation.TextMapPropagator) {
+	resourceConfiguration.textMapPropagator = propagator
+}
+
+// startSpan starts the span of the operation "operationID" with the attributes of "options".
+func (resourceConfiguration *ResourceConfigurationV1) startSpan(ctx context.Context, operationID string, options interface{}) (context.Context, trace.Span) {
+	provider := resourceConfiguration.tracerProvider
+	if provider == nil {
+		provider = otel.GetTracerProvider()
+	}
+	tracer := provider.Tracer(TracerName, trace.WithInstrumentationVersion(common.Version))
+	return tracer.Start(ctx, operationID, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(optionsAttributes(options)...))
+}
+
+// traceRequest records the method and URL of "request" on its span and adds its trace context to its headers.
+func (resourceConfiguration *ResourceConfigurationV1) traceRequest(request *http.Request) {
+	span := trace.SpanFromContext(request.Context())
+	if span.IsRecording() {
+		span.SetAttributes(
+			attribute.String("http.request.method", request.Method),
+			attribute.String("url.full", request.URL.String()),
+		)
+	}
+	propagator := resourceConfiguration.textMapPropagator
+	if propagator == nil {
+		propagator = propagation.TraceContext{}
+	}
+	propagator.Inject(request.Context(), propagation.HeaderCarrier(request.Header))
+}
+
+// endSpan records the outcome of an operation on its span and ends it.
+func endSpan(span trace.Span, response *core.DetailedResponse, err error) {
+	defer span.End()
+	if !span.IsRecording() {
+		return
+	}
+	if response != nil {
+		span.SetAttributes(attribute.Int("http.response.status_code", response.StatusCode))
+		if requestID := response.Headers.Get(requestIDHeader); requestID != "" {
+			span.SetAttributes(SpanAttribute_RequestID.String(requestID))
+		}
+	}
+	if err == nil {
+		return
+	}
+	var attributes []attribute.KeyValue
+	var problem *core.SDKProblem
+	if errors.As(err, &problem) {
+		attributes = append(attributes, SpanAttribute_ProblemID.String(problem.GetID()))
+	}
+	errorType := "error"
+	if response != nil && response.StatusCode >= 400 {
+		errorType = strconv.Itoa(response.StatusCode)
+	}
+	span.SetAttributes(attribute.String("error.type", errorType))
+	span.RecordError(err, trace.WithAttributes(attributes...))
+	span.SetStatus(codes.Error, err.Error())
+}
+
+// optionsAttributes returns the span attributes of the fields of "options" listed in spanOptionFields.
+func optionsAttributes(options interface{}) (attributes []attribute.KeyValue) {
+	value := reflect.ValueOf(options)
+	if value.Kind() != reflect.Ptr || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return nil
+	}
+	value = value.Elem()
+	for _, field := range spanOptionFields {
+		fieldValue := value.FieldByName(field.field)
+		if !fieldValue.IsValid() {
+			continue
+		}
+		if s, ok := fieldValue.Interface().(*string); ok && s != nil {
+			attributes = append(attributes, field.key.String(*s))
+		}
+	}
+	return
+}
@@ -0,0 +1,155 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourceconfigurationv1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/ibm-cos-sdk-go-config/v2/resourceconfigurationv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func spanAttributes(span sdktrace.ReadOnlySpan) map[attribute.Key]attribute.Value {
+	attributes := map[attribute.Key]attribute.Value{}
+	for _, kv := range span.Attributes() {
+		attributes[kv.Key] = kv.Value
+	}
+	return attributes
+}
+
+var _ = Describe(`ResourceConfigurationV1 tracing`, func() {
+	var testServer *httptest.Server
+	var resourceConfigurationService *resourceconfigurationv1.ResourceConfigurationV1
+	var recorder *tracetest.SpanRecorder
+	var tracer trace.Tracer
+	var traceparents []string
+	BeforeEach(func() {
+		traceparents = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			traceparents = append(traceparents, req.Header.Get("traceparent"))
+			res.Header().Set("Content-type", "application/json")
+			res.Header().Set("X-Request-Id", "req-1")
+			switch path := req.URL.EscapedPath(); path {
+			case "/b/my-bucket":
+				fmt.Fprint(res, `{"name": "my-bucket"}`)
+			case "/backup_vaults/my-vault/restores":
+				res.WriteHeader(201)
+				fmt.Fprint(res, `{"restore_id": "r1", "restore_type": "in_place"}`)
+			default:
+				res.WriteHeader(404)
+				fmt.Fprint(res, `{"errors": [{"code": "not_found", "message": "not found"}]}`)
+			}
+		}))
+		var serviceErr error
+		resourceConfigurationService, serviceErr = resourceconfigurationv1.NewResourceConfigurationV1(&resourceconfigurationv1.ResourceConfigurationV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		recorder = tracetest.NewSpanRecorder()
+		provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+		resourceConfigurationService.SetTracerProvider(provider)
+		tracer = provider.Tracer("test")
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Starts a span for each operation and propagates its trace context`, func() {
+		ctx, parent := tracer.Start(context.Background(), "parent")
+		_, _, err := resourceConfigurationService.GetBucketConfigWithContext(ctx, resourceConfigurationService.NewGetBucketConfigOptions("my-bucket"))
+		parent.End()
+		Expect(err).To(BeNil())
+
+		spans := recorder.Ended()
+		Expect(spans).To(HaveLen(2))
+		span := spans[0]
+		Expect(span.Name()).To(Equal("GetBucketConfig"))
+		Expect(span.SpanKind()).To(Equal(trace.SpanKindClient))
+		Expect(span.Parent().SpanID()).To(Equal(parent.SpanContext().SpanID()))
+		Expect(span.InstrumentationScope().Name).To(Equal(resourceconfigurationv1.TracerName))
+		Expect(span.Status().Code).To(Equal(codes.Unset))
+		attributes := spanAttributes(span)
+		Expect(attributes[resourceconfigurationv1.SpanAttribute_Bucket].AsString()).To(Equal("my-bucket"))
+		Expect(attributes["http.request.method"].AsString()).To(Equal("GET"))
+		Expect(attributes["url.full"].AsString()).To(Equal(testServer.URL + "/b/my-bucket"))
+		Expect(attributes["http.response.status_code"].AsInt64()).To(Equal(int64(200)))
+		Expect(attributes[resourceconfigurationv1.SpanAttribute_RequestID].AsString()).To(Equal("req-1"))
+
+		Expect(traceparents).To(Equal([]string{
+			fmt.Sprintf("00-%s-%s-01", span.SpanContext().TraceID(), span.SpanContext().SpanID()),
+		}))
+	})
+	It(`Records the backup vault of an operation`, func() {
+		options := resourceConfigurationService.NewCreateRestoreOptions("my-vault", "range-1", "in_place",
+			CreateMockDateTime("2025-06-01T00:00:00Z"), "crn:v1:bluemix:public:cloud-object-storage:global:a/1:2:bucket:target")
+		_, _, err := resourceConfigurationService.CreateRestoreWithContext(context.Background(), options)
+		Expect(err).To(BeNil())
+
+		spans := recorder.Ended()
+		Expect(spans).To(HaveLen(1))
+		Expect(spans[0].Name()).To(Equal("CreateRestore"))
+		attributes := spanAttributes(spans[0])
+		Expect(attributes[resourceconfigurationv1.SpanAttribute_BackupVaultName].AsString()).To(Equal("my-vault"))
+		Expect(attributes[resourceconfigurationv1.SpanAttribute_RecoveryRangeID].AsString()).To(Equal("range-1"))
+		Expect(attributes["http.response.status_code"].AsInt64()).To(Equal(int64(201)))
+	})
+	It(`Records errors as span events`, func() {
+		_, _, err := resourceConfigurationService.GetBucketConfigWithContext(context.Background(), resourceConfigurationService.NewGetBucketConfigOptions("other-bucket"))
+		Expect(err).ToNot(BeNil())
+
+		spans := recorder.Ended()
+		Expect(spans).To(HaveLen(1))
+		Expect(spans[0].Status().Code).To(Equal(codes.Error))
+		attributes := spanAttributes(spans[0])
+		Expect(attributes["http.response.status_code"].AsInt64()).To(Equal(int64(404)))
+		Expect(attributes["error.type"].AsString()).To(Equal("404"))
+		Expect(spans[0].Events()).To(HaveLen(1))
+		event := spans[0].Events()[0]
+		Expect(event.Name).To(Equal("exception"))
+		var problemID string
+		for _, kv := range event.Attributes {
+			if kv.Key == resourceconfigurationv1.SpanAttribute_ProblemID {
+				problemID = kv.Value.AsString()
+			}
+		}
+		Expect(problemID).ToNot(BeEmpty())
+	})
+	It(`Records validation errors without sending a request`, func() {
+		_, _, err := resourceConfigurationService.GetBucketConfigWithContext(context.Background(), nil)
+		Expect(err).ToNot(BeNil())
+		Expect(traceparents).To(BeEmpty())
+
+		spans := recorder.Ended()
+		Expect(spans).To(HaveLen(1))
+		Expect(spans[0].Status().Code).To(Equal(codes.Error))
+		Expect(spanAttributes(spans[0])["error.type"].AsString()).To(Equal("error"))
+		Expect(spans[0].Events()).To(HaveLen(1))
+	})
+})
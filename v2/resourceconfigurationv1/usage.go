@@ -0,0 +1,412 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourceconfigurationv1
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/ibm-cos-sdk-go-config/v2/common"
+	"github.com/IBM/ibm-cos-sdk-go-config/v2/crn"
+	"github.com/go-openapi/strfmt"
+)
+
+// BytesPerGB is the number of bytes in the GB that prices are quoted per.
+const BytesPerGB = 1000 * 1000 * 1000
+
+// PriceTable : The monthly storage price of backup vaults, per region.
+type PriceTable struct {
+	// The currency of the prices, e.g. "USD".
+	Currency string `json:"currency"`
+
+	// The price of storing one GB for a month in a backup vault, by region.
+	PricePerGBMonth map[string]float64 `json:"price_per_gb_month"`
+
+	// The price of storing one GB for a month in regions missing from PricePerGBMonth. Zero leaves the cost of those
+	// regions unknown.
+	DefaultPricePerGBMonth float64 `json:"default_price_per_gb_month,omitempty"`
+}
+
+// Price returns the price per GB-month in "region", or false if the table has none.
+func (table *PriceTable) Price(region string) (float64, bool) {
+	if price, ok := table.PricePerGBMonth[region]; ok {
+		return price, true
+	}
+	if table.DefaultPricePerGBMonth > 0 {
+		return table.DefaultPricePerGBMonth, true
+	}
+	return 0, false
+}
+
+// cost returns the monthly cost of storing "bytes" in "region", or nil if it is unknown.
+func (table *PriceTable) cost(region string, bytes int64) *float64 {
+	if table == nil {
+		return nil
+	}
+	price, ok := table.Price(region)
+	if !ok {
+		return nil
+	}
+	return core.Float64Ptr(float64(bytes) / BytesPerGB * price)
+}
+
+// UsageReportOptions : The GetUsageReport options.
+type UsageReportOptions struct {
+	// The service instances whose BackupVaults are reported on.
+	ServiceInstanceIDs []string `json:"service_instance_ids" validate:"required,min=1,dive,required"`
+
+	// The prices to calculate costs with. Without one, only usage is reported.
+	PriceTable *PriceTable `json:"price_table,omitempty"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewUsageReportOptions : Instantiate UsageReportOptions
+func (*ResourceConfigurationV1) NewUsageReportOptions(serviceInstanceIDs []string) *UsageReportOptions {
+	return &UsageReportOptions{
+		ServiceInstanceIDs: serviceInstanceIDs,
+	}
+}
+
+// SetPriceTable : Allow user to set PriceTable
+func (_options *UsageReportOptions) SetPriceTable(priceTable *PriceTable) *UsageReportOptions {
+	_options.PriceTable = priceTable
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *UsageReportOptions) SetHeaders(param map[string]string) *UsageReportOptions {
+	options.Headers = param
+	return options
+}
+
+// UsageReport : The storage used by the BackupVaults of a set of service instances, and its cost.
+type UsageReport struct {
+	// The time the usage was collected.
+	GeneratedAt *strfmt.DateTime `json:"generated_at"`
+
+	// The currency of the costs.
+	Currency string `json:"currency,omitempty"`
+
+	// The usage of each BackupVault, ordered by service instance, region and name.
+	Vaults []VaultUsage `json:"vaults"`
+
+	// The usage of each service instance in each region, in the same order.
+	Totals []UsageTotal `json:"totals"`
+}
+
+// VaultUsage : The storage used by one BackupVault, compared with the buckets it protects.
+type VaultUsage struct {
+	// The service instance that owns the BackupVault.
+	ServiceInstanceID string `json:"service_instance_id"`
+
+	// The name of the BackupVault.
+	BackupVaultName string `json:"backup_vault_name"`
+
+	// The region of the BackupVault.
+	Region string `json:"region,omitempty"`
+
+	// The bytes stored in the BackupVault.
+	BytesUsed int64 `json:"bytes_used"`
+
+	// The buckets with RecoveryRanges in the BackupVault.
+	ProtectedBuckets []ProtectedBucketUsage `json:"protected_buckets"`
+
+	// The current and noncurrent bytes stored in the protected buckets that could be read.
+	ProtectedBytes int64 `json:"protected_bytes"`
+
+	// BytesUsed divided by ProtectedBytes; absent when ProtectedBytes is zero.
+	Ratio *float64 `json:"ratio,omitempty"`
+
+	// The monthly cost of BytesUsed; absent without a price for the region.
+	MonthlyCost *float64 `json:"monthly_cost,omitempty"`
+
+	// Why the usage of the BackupVault could not be read.
+	Error string `json:"error,omitempty"`
+}
+
+// ProtectedBucketUsage : The storage used by a bucket that is backed up to a BackupVault.
+type ProtectedBucketUsage struct {
+	// The CRN of the bucket.
+	BucketCrn string `json:"bucket_crn"`
+
+	// The bytes of current object versions.
+	BytesUsed int64 `json:"bytes_used"`
+
+	// The bytes of noncurrent object versions.
+	NoncurrentBytesUsed int64 `json:"noncurrent_bytes_used"`
+
+	// Why the usage of the bucket could not be read.
+	Error string `json:"error,omitempty"`
+}
+
+// UsageTotal : The storage used by the BackupVaults of one service instance in one region.
+type UsageTotal struct {
+	// The service instance.
+	ServiceInstanceID string `json:"service_instance_id"`
+
+	// The region.
+	Region string `json:"region"`
+
+	// The number of BackupVaults.
+	Vaults int `json:"vaults"`
+
+	// The bytes stored in the BackupVaults.
+	BytesUsed int64 `json:"bytes_used"`
+
+	// The bytes stored in the buckets they protect.
+	ProtectedBytes int64 `json:"protected_bytes"`
+
+	// The monthly cost of BytesUsed; absent without a price for the region.
+	MonthlyCost *float64 `json:"monthly_cost,omitempty"`
+}
+
+// usageCSVHeader is the header row of UsageReport.WriteCSV.
+var usageCSVHeader = []string{
+	"service_instance_id", "region", "backup_vault_name", "bytes_used", "protected_buckets", "protected_bytes",
+	"ratio", "monthly_cost", "currency", "error",
+}
+
+// WriteJSON writes the report to "w" as indented JSON.
+func (report *UsageReport) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(report)
+}
+
+// WriteCSV writes the report to "w" as CSV, with a header row and one row per BackupVault. Unknown ratios and costs
+// are left empty.
+func (report *UsageReport) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(usageCSVHeader); err != nil {
+		return err
+	}
+	for _, vault := range report.Vaults {
+		row := []string{
+			vault.ServiceInstanceID, vault.Region, vault.BackupVaultName, strconv.FormatInt(vault.BytesUsed, 10),
+			strconv.Itoa(len(vault.ProtectedBuckets)), strconv.FormatInt(vault.ProtectedBytes, 10),
+			formatFloat(vault.Ratio, 4), formatFloat(vault.MonthlyCost, 2), report.Currency, vault.Error,
+		}
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// WriteTable writes the totals of the report to "w" as aligned columns, one row per service instance and region.
+func (report *UsageReport) WriteTable(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 3, ' ', 0)
+	fmt.Fprintln(tw, "INSTANCE\tREGION\tVAULTS\tVAULT BYTES\tPROTECTED BYTES\tMONTHLY COST")
+	for _, total := range report.Totals {
+		cost := formatFloat(total.MonthlyCost, 2)
+		if cost == "" {
+			cost = "-"
+		} else if report.Currency != "" {
+			cost += " " + report.Currency
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%d\t%d\t%d\t%s\n", total.ServiceInstanceID, orDash(&total.Region), total.Vaults,
+			total.BytesUsed, total.ProtectedBytes, cost)
+	}
+	return tw.Flush()
+}
+
+func formatFloat(value *float64, precision int) string {
+	if value == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*value, 'f', precision, 64)
+}
+
+// GetUsageReport : Report the storage used by BackupVaults and its cost
+// Collects the bytes stored in every BackupVault of the service instances, and compares them with the current and
+// noncurrent bytes of the buckets that have RecoveryRanges in each vault. With a price table, the monthly cost of
+// each vault is calculated from its region's price. Usage is totalled per service instance and region.
+//
+// Failures to read a vault or a protected bucket are recorded in the report rather than returned, so that one
+// inaccessible resource does not hide the others; failing to list the vaults of a service instance is returned.
+func (resourceConfiguration *ResourceConfigurationV1) GetUsageReport(usageReportOptions *UsageReportOptions) (result *UsageReport, err error) {
+	result, err = resourceConfiguration.GetUsageReportWithContext(context.Background(), usageReportOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetUsageReportWithContext is an alternate form of the GetUsageReport method which supports a Context parameter
+func (resourceConfiguration *ResourceConfigurationV1) GetUsageReportWithContext(ctx context.Context, usageReportOptions *UsageReportOptions) (result *UsageReport, err error) {
+	err = core.ValidateNotNil(usageReportOptions, "usageReportOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(usageReportOptions, "usageReportOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	report := &UsageReport{
+		GeneratedAt: dateTimePtr(time.Now()),
+		Vaults:      []VaultUsage{},
+		Totals:      []UsageTotal{},
+	}
+	priceTable := usageReportOptions.PriceTable
+	if priceTable != nil {
+		report.Currency = priceTable.Currency
+	}
+
+	// Buckets can be protected by several vaults; read each once.
+	buckets := map[string]*ProtectedBucketUsage{}
+	for _, instanceID := range usageReportOptions.ServiceInstanceIDs {
+		listBackupVaultsOptions := resourceConfiguration.NewListBackupVaultsOptions(instanceID)
+		listBackupVaultsOptions.SetHeaders(usageReportOptions.Headers)
+		var pager *BackupVaultsPager
+		pager, err = resourceConfiguration.NewBackupVaultsPager(listBackupVaultsOptions)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "usage-list-backup-vaults-error")
+			return
+		}
+		var vaults []string
+		vaults, err = pager.GetAllWithContext(ctx)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "usage-list-backup-vaults-error")
+			return
+		}
+		for _, vaultName := range vaults {
+			vault := resourceConfiguration.vaultUsage(ctx, instanceID, vaultName, buckets, usageReportOptions.Headers)
+			vault.MonthlyCost = priceTable.cost(vault.Region, vault.BytesUsed)
+			report.Vaults = append(report.Vaults, *vault)
+		}
+	}
+
+	sort.SliceStable(report.Vaults, func(i, j int) bool {
+		a, b := &report.Vaults[i], &report.Vaults[j]
+		if a.ServiceInstanceID != b.ServiceInstanceID {
+			return a.ServiceInstanceID < b.ServiceInstanceID
+		}
+		if a.Region != b.Region {
+			return a.Region < b.Region
+		}
+		return a.BackupVaultName < b.BackupVaultName
+	})
+	for _, vault := range report.Vaults {
+		last := len(report.Totals) - 1
+		if last < 0 || report.Totals[last].ServiceInstanceID != vault.ServiceInstanceID || report.Totals[last].Region != vault.Region {
+			report.Totals = append(report.Totals, UsageTotal{ServiceInstanceID: vault.ServiceInstanceID, Region: vault.Region})
+			last++
+		}
+		total := &report.Totals[last]
+		total.Vaults++
+		total.BytesUsed += vault.BytesUsed
+		total.ProtectedBytes += vault.ProtectedBytes
+	}
+	for i := range report.Totals {
+		report.Totals[i].MonthlyCost = priceTable.cost(report.Totals[i].Region, report.Totals[i].BytesUsed)
+	}
+
+	result = report
+	return
+}
+
+// vaultUsage collects the usage of the BackupVault "vaultName" and of the buckets it protects. "buckets" caches the
+// usage of buckets already read.
+func (resourceConfiguration *ResourceConfigurationV1) vaultUsage(ctx context.Context, instanceID string, vaultName string, buckets map[string]*ProtectedBucketUsage, headers map[string]string) *VaultUsage {
+	usage := &VaultUsage{
+		ServiceInstanceID: instanceID,
+		BackupVaultName:   vaultName,
+		ProtectedBuckets:  []ProtectedBucketUsage{},
+	}
+
+	getBackupVaultOptions := resourceConfiguration.NewGetBackupVaultOptions(vaultName)
+	getBackupVaultOptions.SetHeaders(headers)
+	vault, _, err := resourceConfiguration.GetBackupVaultWithContext(ctx, getBackupVaultOptions)
+	if err != nil {
+		usage.Error = err.Error()
+		return usage
+	}
+	usage.Region = core.StringNilMapper(vault.Region)
+	if vault.BytesUsed != nil {
+		usage.BytesUsed = *vault.BytesUsed
+	}
+
+	listRecoveryRangesOptions := resourceConfiguration.NewListRecoveryRangesOptions(vaultName)
+	listRecoveryRangesOptions.SetLatest("true")
+	listRecoveryRangesOptions.SetHeaders(headers)
+	pager, err := resourceConfiguration.NewRecoveryRangesPager(listRecoveryRangesOptions)
+	if err != nil {
+		usage.Error = err.Error()
+		return usage
+	}
+	ranges, err := pager.GetAllWithContext(ctx)
+	if err != nil {
+		usage.Error = err.Error()
+		return usage
+	}
+
+	seen := map[string]bool{}
+	for _, recoveryRange := range ranges {
+		bucketCrn := core.StringNilMapper(recoveryRange.SourceResourceCrn)
+		if bucketCrn == "" || seen[bucketCrn] {
+			continue
+		}
+		seen[bucketCrn] = true
+		bucket, ok := buckets[bucketCrn]
+		if !ok {
+			bucket = resourceConfiguration.protectedBucketUsage(ctx, bucketCrn, headers)
+			buckets[bucketCrn] = bucket
+		}
+		usage.ProtectedBuckets = append(usage.ProtectedBuckets, *bucket)
+		usage.ProtectedBytes += bucket.BytesUsed + bucket.NoncurrentBytesUsed
+	}
+	if usage.ProtectedBytes > 0 {
+		usage.Ratio = core.Float64Ptr(float64(usage.BytesUsed) / float64(usage.ProtectedBytes))
+	}
+	return usage
+}
+
+// protectedBucketUsage reads the usage of the bucket with the CRN "bucketCrn".
+func (resourceConfiguration *ResourceConfigurationV1) protectedBucketUsage(ctx context.Context, bucketCrn string, headers map[string]string) *ProtectedBucketUsage {
+	usage := &ProtectedBucketUsage{BucketCrn: bucketCrn}
+	parsed, err := crn.ParseBucket(bucketCrn)
+	if err != nil {
+		usage.Error = err.Error()
+		return usage
+	}
+	getBucketConfigOptions := resourceConfiguration.NewGetBucketConfigOptions(parsed.Resource)
+	getBucketConfigOptions.SetHeaders(headers)
+	bucket, _, err := resourceConfiguration.GetBucketConfigWithContext(ctx, getBucketConfigOptions)
+	if err != nil {
+		usage.Error = err.Error()
+		return usage
+	}
+	if bucket.BytesUsed != nil {
+		usage.BytesUsed = *bucket.BytesUsed
+	}
+	if bucket.NoncurrentBytesUsed != nil {
+		usage.NoncurrentBytesUsed = *bucket.NoncurrentBytesUsed
+	}
+	return usage
+}
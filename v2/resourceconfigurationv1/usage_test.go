@@ -0,0 +1,187 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourceconfigurationv1_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/ibm-cos-sdk-go-config/v2/resourceconfigurationv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ResourceConfigurationV1 usage report`, func() {
+	const crnPrefix = "crn:v1:bluemix:public:cloud-object-storage:global:a/3bf0d9003abfb5d29761c3e97696b71c:d6f04d83-6c4f-4a62-a165-696756d63903:"
+	var testServer *httptest.Server
+	var resourceConfigurationService *resourceconfigurationv1.ResourceConfigurationV1
+	var bucketReads map[string]int
+	BeforeEach(func() {
+		bucketReads = map[string]int{}
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.Method).To(Equal("GET"))
+			res.Header().Set("Content-type", "application/json")
+			switch path := req.URL.EscapedPath(); path {
+			case "/backup_vaults":
+				vaults := map[string]string{
+					"inst-1": `["vault-b", "vault-a"]`,
+					"inst-2": `["vault-c", "vault-broken"]`,
+				}
+				fmt.Fprintf(res, `{"backup_vaults": %s}`, vaults[req.URL.Query().Get("service_instance_id")])
+			case "/backup_vaults/vault-a":
+				fmt.Fprint(res, `{"backup_vault_name": "vault-a", "region": "us-south", "bytes_used": 3000000000}`)
+			case "/backup_vaults/vault-b":
+				fmt.Fprint(res, `{"backup_vault_name": "vault-b", "region": "eu-de", "bytes_used": 500}`)
+			case "/backup_vaults/vault-c":
+				fmt.Fprint(res, `{"backup_vault_name": "vault-c", "region": "us-south", "bytes_used": 2000000000}`)
+			case "/backup_vaults/vault-broken":
+				res.WriteHeader(500)
+				fmt.Fprint(res, `{"errors": [{"code": "internal_error", "message": "vault unavailable"}]}`)
+			case "/backup_vaults/vault-a/recovery_ranges":
+				Expect(req.URL.Query().Get("latest")).To(Equal("true"))
+				fmt.Fprintf(res, `{"recovery_ranges": [
+					{"recovery_range_id": "r1", "source_resource_crn": "%[1]sbucket:b1"},
+					{"recovery_range_id": "r2", "source_resource_crn": "%[1]sbucket:b2"},
+					{"recovery_range_id": "r3", "source_resource_crn": "%[1]sbucket:b2"}
+				]}`, crnPrefix)
+			case "/backup_vaults/vault-b/recovery_ranges":
+				fmt.Fprintf(res, `{"recovery_ranges": [
+					{"recovery_range_id": "r4", "source_resource_crn": "%[1]sbucket:b1"},
+					{"recovery_range_id": "r5", "source_resource_crn": "%[1]sbucket:gone"}
+				]}`, crnPrefix)
+			case "/backup_vaults/vault-c/recovery_ranges":
+				fmt.Fprint(res, `{"recovery_ranges": []}`)
+			case "/b/b1":
+				bucketReads["b1"]++
+				fmt.Fprint(res, `{"name": "b1", "bytes_used": 1000000000, "noncurrent_bytes_used": 500000000}`)
+			case "/b/b2":
+				bucketReads["b2"]++
+				fmt.Fprint(res, `{"name": "b2", "bytes_used": 500000000}`)
+			case "/b/gone":
+				res.WriteHeader(404)
+				fmt.Fprint(res, `{"errors": [{"code": "not_found", "message": "bucket not found"}]}`)
+			default:
+				Fail("unexpected request " + path)
+			}
+		}))
+		var serviceErr error
+		resourceConfigurationService, serviceErr = resourceconfigurationv1.NewResourceConfigurationV1(&resourceconfigurationv1.ResourceConfigurationV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+	It(`Invoke GetUsageReport successfully`, func() {
+		priceTable := &resourceconfigurationv1.PriceTable{
+			Currency:        "USD",
+			PricePerGBMonth: map[string]float64{"us-south": 0.02},
+		}
+		usageReportOptionsModel := resourceConfigurationService.NewUsageReportOptions([]string{"inst-1", "inst-2"})
+		usageReportOptionsModel.SetPriceTable(priceTable)
+		report, operationErr := resourceConfigurationService.GetUsageReport(usageReportOptionsModel)
+		Expect(operationErr).To(BeNil())
+		Expect(report.Currency).To(Equal("USD"))
+		Expect(bucketReads).To(Equal(map[string]int{"b1": 1, "b2": 1}))
+
+		names := []string{}
+		for _, vault := range report.Vaults {
+			names = append(names, vault.ServiceInstanceID+"/"+vault.Region+"/"+vault.BackupVaultName)
+		}
+		Expect(names).To(Equal([]string{"inst-1/eu-de/vault-b", "inst-1/us-south/vault-a", "inst-2//vault-broken", "inst-2/us-south/vault-c"}))
+
+		vaultB := report.Vaults[0]
+		Expect(vaultB.ProtectedBuckets).To(HaveLen(2))
+		Expect(vaultB.ProtectedBuckets[1].Error).To(ContainSubstring("bucket not found"))
+		Expect(vaultB.ProtectedBytes).To(Equal(int64(1500000000)))
+		Expect(vaultB.MonthlyCost).To(BeNil())
+
+		vaultA := report.Vaults[1]
+		Expect(vaultA.ProtectedBuckets).To(HaveLen(2))
+		Expect(vaultA.ProtectedBytes).To(Equal(int64(2000000000)))
+		Expect(*vaultA.Ratio).To(Equal(1.5))
+		Expect(*vaultA.MonthlyCost).To(BeNumerically("~", 0.06, 1e-9))
+
+		Expect(report.Vaults[2].Error).To(ContainSubstring("vault unavailable"))
+		Expect(report.Vaults[3].Ratio).To(BeNil())
+
+		Expect(report.Totals).To(HaveLen(4))
+		Expect(report.Totals[1].ServiceInstanceID).To(Equal("inst-1"))
+		Expect(report.Totals[1].Region).To(Equal("us-south"))
+		Expect(report.Totals[1].Vaults).To(Equal(1))
+		Expect(*report.Totals[3].MonthlyCost).To(BeNumerically("~", 0.04, 1e-9))
+
+		var buffer bytes.Buffer
+		Expect(report.WriteCSV(&buffer)).To(Succeed())
+		rows, err := csv.NewReader(&buffer).ReadAll()
+		Expect(err).To(BeNil())
+		Expect(rows).To(HaveLen(5))
+		Expect(rows[0]).To(Equal([]string{"service_instance_id", "region", "backup_vault_name", "bytes_used", "protected_buckets", "protected_bytes", "ratio", "monthly_cost", "currency", "error"}))
+		Expect(rows[1]).To(Equal([]string{"inst-1", "eu-de", "vault-b", "500", "2", "1500000000", "0.0000", "", "USD", ""}))
+		Expect(rows[2]).To(Equal([]string{"inst-1", "us-south", "vault-a", "3000000000", "2", "2000000000", "1.5000", "0.06", "USD", ""}))
+
+		var table bytes.Buffer
+		Expect(report.WriteTable(&table)).To(Succeed())
+		lines := strings.Split(strings.TrimSpace(table.String()), "\n")
+		Expect(lines).To(HaveLen(5))
+		Expect(lines[0]).To(MatchRegexp(`^INSTANCE\s+REGION\s+VAULTS\s+VAULT BYTES\s+PROTECTED BYTES\s+MONTHLY COST$`))
+		Expect(lines[2]).To(MatchRegexp(`^inst-1\s+us-south\s+1\s+3000000000\s+2000000000\s+0.06 USD$`))
+	})
+	It(`Invoke GetUsageReport without prices`, func() {
+		report, operationErr := resourceConfigurationService.GetUsageReport(resourceConfigurationService.NewUsageReportOptions([]string{"inst-1"}))
+		Expect(operationErr).To(BeNil())
+		Expect(report.Currency).To(BeEmpty())
+		Expect(report.Vaults).To(HaveLen(2))
+		for _, vault := range report.Vaults {
+			Expect(vault.MonthlyCost).To(BeNil())
+		}
+	})
+	It(`Invoke GetUsageReport with error`, func() {
+		report, operationErr := resourceConfigurationService.GetUsageReport(nil)
+		Expect(operationErr).ToNot(BeNil())
+		Expect(report).To(BeNil())
+
+		report, operationErr = resourceConfigurationService.GetUsageReport(resourceConfigurationService.NewUsageReportOptions([]string{}))
+		Expect(operationErr).ToNot(BeNil())
+		Expect(report).To(BeNil())
+
+		resourceConfigurationService.SetServiceURL("http://localhost:1")
+		report, operationErr = resourceConfigurationService.GetUsageReport(resourceConfigurationService.NewUsageReportOptions([]string{"inst-1"}))
+		Expect(operationErr).ToNot(BeNil())
+		Expect(report).To(BeNil())
+	})
+	It(`Look up prices`, func() {
+		priceTable := &resourceconfigurationv1.PriceTable{PricePerGBMonth: map[string]float64{"us-south": 0.02}}
+		_, ok := priceTable.Price("eu-de")
+		Expect(ok).To(BeFalse())
+		priceTable.DefaultPricePerGBMonth = 0.03
+		price, ok := priceTable.Price("eu-de")
+		Expect(ok).To(BeTrue())
+		Expect(price).To(Equal(0.03))
+		price, _ = priceTable.Price("us-south")
+		Expect(price).To(Equal(0.02))
+	})
+})
@@ -0,0 +1,407 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourceconfigurationv1
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/ibm-cos-sdk-go-config/v2/crn"
+)
+
+// Retention bounds of DeleteAfterDays.
+const (
+	// MinRetentionDays is the shortest retention that can be requested. The API documents no upper bound.
+	MinRetentionDays = 1
+
+	// IndefiniteRetentionDays denotes indefinite retention in DeleteAfterDaysWithIndefinite. It cannot be requested.
+	IndefiniteRetentionDays = -1
+)
+
+var (
+	// namePattern matches the characters bucket and backup vault names are made of, beginning and ending with a
+	// letter or number.
+	namePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9.-]*[a-z0-9]$`)
+
+	// ipAddressPattern matches names that resemble IP addresses.
+	ipAddressPattern = regexp.MustCompile(`^\d+\.\d+\.\d+\.\d+$`)
+
+	// policyNamePattern matches the characters policy names are made of.
+	policyNamePattern = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+)
+
+// FieldError : A value of an options struct that the service would reject.
+type FieldError struct {
+	// The JSON name of the field, e.g. "initial_retention.delete_after_days".
+	Field string
+
+	// The rejected value.
+	Value interface{}
+
+	// Why the value is rejected.
+	Reason string
+}
+
+// Error returns the field name and the reason.
+func (fieldError *FieldError) Error() string {
+	return fmt.Sprintf("%s: %s", fieldError.Field, fieldError.Reason)
+}
+
+// ValidationError : The field errors found by the Validate method of an options struct. Operations return it
+// wrapped in their SDK error; use errors.As to retrieve it.
+type ValidationError struct {
+	Errors []*FieldError
+}
+
+// Error lists the field errors.
+func (validationError *ValidationError) Error() string {
+	messages := make([]string, len(validationError.Errors))
+	for i, fieldError := range validationError.Errors {
+		messages[i] = fieldError.Error()
+	}
+	return "invalid options: " + strings.Join(messages, "; ")
+}
+
+// Unwrap returns the field errors, so that errors.As can retrieve the first *FieldError.
+func (validationError *ValidationError) Unwrap() []error {
+	errs := make([]error, len(validationError.Errors))
+	for i, fieldError := range validationError.Errors {
+		errs[i] = fieldError
+	}
+	return errs
+}
+
+// EnableClientValidation makes every operation check its options with their Validate method before sending the
+// request, so that names, retention and enum values the service would reject fail without a round trip.
+func (resourceConfiguration *ResourceConfigurationV1) EnableClientValidation() {
+	resourceConfiguration.clientValidation = true
+}
+
+// DisableClientValidation stops operations from checking their options beyond the required fields.
+func (resourceConfiguration *ResourceConfigurationV1) DisableClientValidation() {
+	resourceConfiguration.clientValidation = false
+}
+
+// IsClientValidationEnabled reports whether operations check their options before sending the request.
+func (resourceConfiguration *ResourceConfigurationV1) IsClientValidationEnabled() bool {
+	return resourceConfiguration.clientValidation
+}
+
+// validateOptions calls the Validate method of "options" when client validation is enabled.
+func (resourceConfiguration *ResourceConfigurationV1) validateOptions(options interface{ Validate() error }) error {
+	if !resourceConfiguration.clientValidation {
+		return nil
+	}
+	return options.Validate()
+}
+
+// ValidateBucketName checks the naming rules of buckets: 3 to 63 lowercase letters, numbers, dots and dashes,
+// beginning and ending with a letter or number, without consecutive dots or dashes, and not resembling an IP address.
+func ValidateBucketName(name string) error {
+	return fieldError("bucket", name, checkName(name))
+}
+
+// ValidateBackupVaultName checks the naming rules of backup vaults, which are the same as those of buckets.
+func ValidateBackupVaultName(name string) error {
+	return fieldError("backup_vault_name", name, checkName(name))
+}
+
+// ValidatePolicyName checks that a backup policy name is made of letters, numbers, underscores, hyphens and periods.
+func ValidatePolicyName(name string) error {
+	return fieldError("policy_name", name, checkPolicyName(name))
+}
+
+// ValidateRetentionDays checks that a retention is at least MinRetentionDays.
+func ValidateRetentionDays(days int64) error {
+	return fieldError("delete_after_days", days, checkRetentionDays(days))
+}
+
+func fieldError(field string, value interface{}, reason string) error {
+	if reason == "" {
+		return nil
+	}
+	return &FieldError{Field: field, Value: value, Reason: reason}
+}
+
+func checkName(name string) string {
+	switch {
+	case len(name) < 3 || len(name) > 63:
+		return fmt.Sprintf("must be between 3 and 63 characters long, not %d", len(name))
+	case !namePattern.MatchString(name):
+		return "must be made of lowercase letters, numbers, dots and dashes, and begin and end with a letter or number"
+	case strings.Contains(name, "..") || strings.Contains(name, "--") || strings.Contains(name, ".-") || strings.Contains(name, "-."):
+		return "must not contain consecutive dots or dashes"
+	case ipAddressPattern.MatchString(name):
+		return "must not resemble an IP address"
+	}
+	return ""
+}
+
+func checkPolicyName(name string) string {
+	if !policyNamePattern.MatchString(name) {
+		return "must be made of letters, numbers, underscores, hyphens and periods"
+	}
+	return ""
+}
+
+func checkRetentionDays(days int64) string {
+	if days == IndefiniteRetentionDays {
+		return "indefinite retention cannot be requested"
+	}
+	if days < MinRetentionDays {
+		return fmt.Sprintf("must be at least %d days", MinRetentionDays)
+	}
+	return ""
+}
+
+// validator collects the field errors of an options struct. Nil fields are skipped; required fields are checked by
+// core.ValidateStruct.
+type validator struct {
+	errors []*FieldError
+}
+
+func (v *validator) check(field string, value *string, check func(string) string) {
+	if value == nil {
+		return
+	}
+	if reason := check(*value); reason != "" {
+		v.errors = append(v.errors, &FieldError{Field: field, Value: *value, Reason: reason})
+	}
+}
+
+// checkName checks the naming rules of a bucket or backup vault being created.
+func (v *validator) checkName(field string, value *string) {
+	v.check(field, value, checkName)
+}
+
+// checkExisting checks the name or ID of an existing resource, such as a bucket or backup vault that may predate the
+// naming rules, so it only has to be non-empty.
+func (v *validator) checkExisting(field string, value *string) {
+	v.check(field, value, func(value string) string {
+		if value == "" {
+			return "must not be empty"
+		}
+		return ""
+	})
+}
+
+func (v *validator) checkEnum(field string, value *string, allowed ...string) {
+	v.check(field, value, func(value string) string {
+		for _, a := range allowed {
+			if value == a {
+				return ""
+			}
+		}
+		return fmt.Sprintf("must be one of %q", allowed)
+	})
+}
+
+func (v *validator) checkCRN(field string, value *string, parse func(string) (*crn.CRN, error)) {
+	v.check(field, value, func(value string) string {
+		if _, err := parse(value); err != nil {
+			return err.Error()
+		}
+		return ""
+	})
+}
+
+func (v *validator) checkRetention(field string, value *DeleteAfterDays) {
+	if value == nil {
+		return
+	}
+	v.checkRetentionDays(field+".delete_after_days", value.DeleteAfterDays)
+}
+
+func (v *validator) checkRetentionDays(field string, value *int64) {
+	if value == nil {
+		return
+	}
+	if reason := checkRetentionDays(*value); reason != "" {
+		v.errors = append(v.errors, &FieldError{Field: field, Value: *value, Reason: reason})
+	}
+}
+
+func (v *validator) err() error {
+	if len(v.errors) == 0 {
+		return nil
+	}
+	return &ValidationError{Errors: v.errors}
+}
+
+// Validate checks the options against the rules the service enforces.
+func (options *CreateBackupPolicyOptions) Validate() error {
+	v := &validator{}
+	v.checkExisting("bucket", options.Bucket)
+	v.checkRetention("initial_retention", options.InitialRetention)
+	v.check("policy_name", options.PolicyName, checkPolicyName)
+	v.checkCRN("target_backup_vault_crn", options.TargetBackupVaultCrn, crn.ParseBackupVault)
+	v.checkEnum("backup_type", options.BackupType, CreateBackupPolicyOptions_BackupType_Continuous)
+	return v.err()
+}
+
+// Validate checks the options against the rules the service enforces.
+func (options *ListBackupPoliciesOptions) Validate() error {
+	v := &validator{}
+	v.checkExisting("bucket", options.Bucket)
+	return v.err()
+}
+
+// Validate checks the options against the rules the service enforces.
+func (options *GetBackupPolicyOptions) Validate() error {
+	v := &validator{}
+	v.checkExisting("bucket", options.Bucket)
+	return v.err()
+}
+
+// Validate checks the options against the rules the service enforces.
+func (options *DeleteBackupPolicyOptions) Validate() error {
+	v := &validator{}
+	v.checkExisting("bucket", options.Bucket)
+	return v.err()
+}
+
+// Validate checks the options against the rules the service enforces.
+func (options *ListBackupVaultsOptions) Validate() error {
+	v := &validator{}
+	v.checkExisting("service_instance_id", options.ServiceInstanceID)
+	return v.err()
+}
+
+// Validate checks the options against the rules the service enforces.
+func (options *CreateBackupVaultOptions) Validate() error {
+	v := &validator{}
+	v.checkName("backup_vault_name", options.BackupVaultName)
+	v.checkCRN("sse_kp_customer_root_key_crn", options.SseKpCustomerRootKeyCrn, crn.ParseRootKey)
+	return v.err()
+}
+
+// Validate checks the options against the rules the service enforces.
+func (options *GetBackupVaultOptions) Validate() error {
+	v := &validator{}
+	v.checkExisting("backup_vault_name", options.BackupVaultName)
+	return v.err()
+}
+
+// Validate checks the options against the rules the service enforces.
+func (options *UpdateBackupVaultOptions) Validate() error {
+	v := &validator{}
+	v.checkExisting("backup_vault_name", options.BackupVaultName)
+	return v.err()
+}
+
+// Validate checks the options against the rules the service enforces.
+func (options *DeleteBackupVaultOptions) Validate() error {
+	v := &validator{}
+	v.checkExisting("backup_vault_name", options.BackupVaultName)
+	return v.err()
+}
+
+// Validate checks the options against the rules the service enforces.
+func (options *GetBucketConfigOptions) Validate() error {
+	v := &validator{}
+	v.checkExisting("bucket", options.Bucket)
+	return v.err()
+}
+
+// Validate checks the options against the rules the service enforces.
+func (options *UpdateBucketConfigOptions) Validate() error {
+	v := &validator{}
+	v.checkExisting("bucket", options.Bucket)
+	return v.err()
+}
+
+// Validate checks the options against the rules the service enforces.
+func (options *ListRecoveryRangesOptions) Validate() error {
+	v := &validator{}
+	v.checkExisting("backup_vault_name", options.BackupVaultName)
+	v.checkCRN("source_resource_crn", options.SourceResourceCrn, crn.ParseBucket)
+	v.check("latest", options.Latest, func(value string) string {
+		// The service accepts any case.
+		if !strings.EqualFold(value, "true") && !strings.EqualFold(value, "false") {
+			return `must be "true" or "false"`
+		}
+		return ""
+	})
+	return v.err()
+}
+
+// Validate checks the options against the rules the service enforces.
+func (options *GetSourceResourceRecoveryRangeOptions) Validate() error {
+	v := &validator{}
+	v.checkExisting("backup_vault_name", options.BackupVaultName)
+	return v.err()
+}
+
+// Validate checks the options against the rules the service enforces. The retention of the patch is checked
+// whether it was built with RecoveryRangePatch.AsPatch or by hand.
+func (options *PatchSourceResourceRecoveryRangeOptions) Validate() error {
+	v := &validator{}
+	v.checkExisting("backup_vault_name", options.BackupVaultName)
+	if retention, ok := options.RecoveryRangePatch["retention"]; ok {
+		v.checkRetention("RecoveryRange_patch.retention", patchRetention(retention))
+	}
+	return v.err()
+}
+
+// patchRetention returns the retention of a RecoveryRange patch, or nil if its days are not a number.
+func patchRetention(retention interface{}) *DeleteAfterDays {
+	var days interface{}
+	switch retention := retention.(type) {
+	case *DeleteAfterDays:
+		return retention
+	case map[string]interface{}:
+		days = retention["delete_after_days"]
+	default:
+		return nil
+	}
+	switch days := days.(type) {
+	case *int64:
+		return &DeleteAfterDays{DeleteAfterDays: days}
+	case int64:
+		return &DeleteAfterDays{DeleteAfterDays: core.Int64Ptr(days)}
+	case int:
+		return &DeleteAfterDays{DeleteAfterDays: core.Int64Ptr(int64(days))}
+	case float64:
+		return &DeleteAfterDays{DeleteAfterDays: core.Int64Ptr(int64(days))}
+	}
+	return nil
+}
+
+// Validate checks the options against the rules the service enforces.
+func (options *CreateRestoreOptions) Validate() error {
+	v := &validator{}
+	v.checkExisting("backup_vault_name", options.BackupVaultName)
+	v.checkEnum("restore_type", options.RestoreType, CreateRestoreOptions_RestoreType_InPlace)
+	v.checkCRN("target_resource_crn", options.TargetResourceCrn, crn.ParseBucket)
+	return v.err()
+}
+
+// Validate checks the options against the rules the service enforces.
+func (options *ListRestoresOptions) Validate() error {
+	v := &validator{}
+	v.checkExisting("backup_vault_name", options.BackupVaultName)
+	return v.err()
+}
+
+// Validate checks the options against the rules the service enforces.
+func (options *GetRestoreOptions) Validate() error {
+	v := &validator{}
+	v.checkExisting("backup_vault_name", options.BackupVaultName)
+	return v.err()
+}
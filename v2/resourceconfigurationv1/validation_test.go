@@ -0,0 +1,196 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourceconfigurationv1_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/ibm-cos-sdk-go-config/v2/resourceconfigurationv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ResourceConfigurationV1 client validation`, func() {
+	const vaultCRN = "crn:v1:bluemix:public:cloud-object-storage:global:a/3bf0d9003abfb5d29761c3e97696b71c:d6f04d83-6c4f-4a62-a165-696756d63903:backup-vault:my-vault"
+	const bucketCRN = "crn:v1:bluemix:public:cloud-object-storage:global:a/3bf0d9003abfb5d29761c3e97696b71c:d6f04d83-6c4f-4a62-a165-696756d63903:bucket:my-bucket"
+	var resourceConfigurationService *resourceconfigurationv1.ResourceConfigurationV1
+	BeforeEach(func() {
+		var serviceErr error
+		resourceConfigurationService, serviceErr = resourceconfigurationv1.NewResourceConfigurationV1(&resourceconfigurationv1.ResourceConfigurationV1Options{
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	It(`Invoke ValidateBucketName and ValidateBackupVaultName`, func() {
+		for _, name := range []string{"abc", "my-bucket", "my.bucket.01", strings.Repeat("a", 63), "1.2.3"} {
+			Expect(resourceconfigurationv1.ValidateBucketName(name)).To(Succeed(), name)
+			Expect(resourceconfigurationv1.ValidateBackupVaultName(name)).To(Succeed(), name)
+		}
+		for name, reason := range map[string]string{
+			"ab":                    "between 3 and 63",
+			strings.Repeat("a", 64): "between 3 and 63",
+			"testString":            "lowercase",
+			"-bucket":               "begin and end",
+			"bucket.":               "begin and end",
+			"my_bucket":             "lowercase",
+			"my..bucket":            "consecutive",
+			"my--bucket":            "consecutive",
+			"my.-bucket":            "consecutive",
+			"192.168.1.1":           "IP address",
+		} {
+			err := resourceconfigurationv1.ValidateBucketName(name)
+			Expect(err).ToNot(BeNil(), name)
+			Expect(err.Error()).To(HavePrefix("bucket: "))
+			Expect(err.Error()).To(ContainSubstring(reason), name)
+
+			var fieldError *resourceconfigurationv1.FieldError
+			Expect(errors.As(resourceconfigurationv1.ValidateBackupVaultName(name), &fieldError)).To(BeTrue())
+			Expect(fieldError.Field).To(Equal("backup_vault_name"))
+			Expect(fieldError.Value).To(Equal(name))
+		}
+	})
+	It(`Invoke ValidatePolicyName and ValidateRetentionDays`, func() {
+		Expect(resourceconfigurationv1.ValidatePolicyName("My_Policy-1.0")).To(Succeed())
+		Expect(resourceconfigurationv1.ValidatePolicyName("")).ToNot(Succeed())
+		Expect(resourceconfigurationv1.ValidatePolicyName("my policy")).ToNot(Succeed())
+
+		Expect(resourceconfigurationv1.ValidateRetentionDays(resourceconfigurationv1.MinRetentionDays)).To(Succeed())
+		Expect(resourceconfigurationv1.ValidateRetentionDays(100000)).To(Succeed())
+		Expect(resourceconfigurationv1.ValidateRetentionDays(0)).ToNot(Succeed())
+		err := resourceconfigurationv1.ValidateRetentionDays(resourceconfigurationv1.IndefiniteRetentionDays)
+		Expect(err.Error()).To(ContainSubstring("indefinite"))
+	})
+	It(`Invoke Validate on options`, func() {
+		deleteAfterDaysModel := &resourceconfigurationv1.DeleteAfterDays{
+			DeleteAfterDays: core.Int64Ptr(int64(0)),
+		}
+		createBackupPolicyOptionsModel := resourceConfigurationService.NewCreateBackupPolicyOptions("", deleteAfterDaysModel, "my policy", "testString", "incremental")
+		err := createBackupPolicyOptionsModel.Validate()
+		var validationError *resourceconfigurationv1.ValidationError
+		Expect(errors.As(err, &validationError)).To(BeTrue())
+		fields := []string{}
+		for _, fieldError := range validationError.Errors {
+			fields = append(fields, fieldError.Field)
+		}
+		Expect(fields).To(Equal([]string{"bucket", "initial_retention.delete_after_days", "policy_name", "target_backup_vault_crn", "backup_type"}))
+		Expect(err.Error()).To(HavePrefix("invalid options: bucket: must not be empty"))
+
+		deleteAfterDaysModel.DeleteAfterDays = core.Int64Ptr(int64(30))
+		createBackupPolicyOptionsModel = resourceConfigurationService.NewCreateBackupPolicyOptions("my-bucket", deleteAfterDaysModel, "my-policy", vaultCRN, resourceconfigurationv1.CreateBackupPolicyOptions_BackupType_Continuous)
+		Expect(createBackupPolicyOptionsModel.Validate()).To(Succeed())
+
+		// Existing buckets and backup vaults may predate the naming rules.
+		createBackupPolicyOptionsModel = resourceConfigurationService.NewCreateBackupPolicyOptions("My_Old_Bucket", deleteAfterDaysModel, "my-policy", vaultCRN, resourceconfigurationv1.CreateBackupPolicyOptions_BackupType_Continuous)
+		Expect(createBackupPolicyOptionsModel.Validate()).To(Succeed())
+		Expect(resourceConfigurationService.NewGetBucketConfigOptions("My_Old_Bucket").Validate()).To(Succeed())
+		Expect(resourceConfigurationService.NewListBackupPoliciesOptions("My_Old_Bucket").Validate()).To(Succeed())
+		Expect(resourceConfigurationService.NewGetBackupVaultOptions("Old_Vault").Validate()).To(Succeed())
+		Expect(resourceConfigurationService.NewListRestoresOptions("Old_Vault").Validate()).To(Succeed())
+		err = resourceConfigurationService.NewGetBucketConfigOptions("").Validate()
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(Equal("invalid options: bucket: must not be empty"))
+
+		createBackupVaultOptionsModel := resourceConfigurationService.NewCreateBackupVaultOptions("testString", "Old_Vault", "us-south")
+		Expect(createBackupVaultOptionsModel.Validate()).ToNot(Succeed())
+		createBackupVaultOptionsModel = resourceConfigurationService.NewCreateBackupVaultOptions("testString", "my-vault", "us-south")
+		Expect(createBackupVaultOptionsModel.Validate()).To(Succeed())
+		createBackupVaultOptionsModel.SetSseKpCustomerRootKeyCrn(bucketCRN)
+		Expect(createBackupVaultOptionsModel.Validate()).ToNot(Succeed())
+		for _, service := range []string{"kms", "hs-crypto"} {
+			createBackupVaultOptionsModel.SetSseKpCustomerRootKeyCrn("crn:v1:bluemix:public:" + service + ":us-south:a/3bf0d9003abfb5d29761c3e97696b71c:12e8c9c2-a162-472d-b7d6-8b9a86b815a6:key:02fd6835-6001-4482-a892-13bd2085f75d")
+			Expect(createBackupVaultOptionsModel.Validate()).To(Succeed(), service)
+		}
+
+		listRecoveryRangesOptionsModel := resourceConfigurationService.NewListRecoveryRangesOptions("my-vault")
+		listRecoveryRangesOptionsModel.SetSourceResourceCrn(bucketCRN)
+		listRecoveryRangesOptionsModel.SetLatest("TRUE")
+		Expect(listRecoveryRangesOptionsModel.Validate()).To(Succeed())
+		listRecoveryRangesOptionsModel.SetLatest("yes")
+		Expect(listRecoveryRangesOptionsModel.Validate()).ToNot(Succeed())
+
+		createRestoreOptionsModel := resourceConfigurationService.NewCreateRestoreOptions("my-vault", "testString", "copy", CreateMockDateTime("2019-01-01T12:00:00.000Z"), bucketCRN)
+		err = createRestoreOptionsModel.Validate()
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(Equal(`invalid options: restore_type: must be one of ["in_place"]`))
+	})
+	It(`Invoke Validate on a RecoveryRange patch`, func() {
+		recoveryRangePatchModel := &resourceconfigurationv1.RecoveryRangePatch{
+			Retention: &resourceconfigurationv1.DeleteAfterDays{
+				DeleteAfterDays: core.Int64Ptr(int64(0)),
+			},
+		}
+		recoveryRangePatchModelAsPatch, asPatchErr := recoveryRangePatchModel.AsPatch()
+		Expect(asPatchErr).To(BeNil())
+		patchOptionsModel := resourceConfigurationService.NewPatchSourceResourceRecoveryRangeOptions("my-vault", "testString", recoveryRangePatchModelAsPatch)
+		err := patchOptionsModel.Validate()
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("RecoveryRange_patch.retention.delete_after_days: must be at least 1 days"))
+
+		// Patches decoded from JSON carry float64 values.
+		patchOptionsModel.SetRecoveryRangePatch(map[string]interface{}{
+			"retention": map[string]interface{}{"delete_after_days": float64(90)},
+		})
+		Expect(patchOptionsModel.Validate()).To(Succeed())
+	})
+	Context(`Using mock server endpoint`, func() {
+		var testServer *httptest.Server
+		var requests int32
+		BeforeEach(func() {
+			atomic.StoreInt32(&requests, 0)
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				atomic.AddInt32(&requests, 1)
+				res.Header().Set("Content-type", "application/json")
+				res.WriteHeader(200)
+				fmt.Fprint(res, `{"backup_vault_name": "testString"}`)
+			}))
+			Expect(resourceConfigurationService.SetServiceURL(testServer.URL)).To(Succeed())
+		})
+		AfterEach(func() {
+			testServer.Close()
+		})
+		It(`Invoke CreateBackupVault with client validation`, func() {
+			createBackupVaultOptionsModel := resourceConfigurationService.NewCreateBackupVaultOptions("testString", "testString", "us-south")
+			Expect(resourceConfigurationService.IsClientValidationEnabled()).To(BeFalse())
+			_, _, operationErr := resourceConfigurationService.CreateBackupVault(createBackupVaultOptionsModel)
+			Expect(operationErr).To(BeNil())
+
+			resourceConfigurationService.EnableClientValidation()
+			Expect(resourceConfigurationService.Clone().IsClientValidationEnabled()).To(BeTrue())
+			result, response, operationErr := resourceConfigurationService.CreateBackupVault(createBackupVaultOptionsModel)
+			Expect(operationErr).ToNot(BeNil())
+			Expect(response).To(BeNil())
+			Expect(result).To(BeNil())
+			Expect(atomic.LoadInt32(&requests)).To(Equal(int32(1)))
+
+			var fieldError *resourceconfigurationv1.FieldError
+			Expect(errors.As(operationErr, &fieldError)).To(BeTrue())
+			Expect(fieldError.Field).To(Equal("backup_vault_name"))
+			Expect(fieldError.Value).To(Equal("testString"))
+
+			resourceConfigurationService.DisableClientValidation()
+			_, _, operationErr = resourceConfigurationService.CreateBackupVault(createBackupVaultOptionsModel)
+			Expect(operationErr).To(BeNil())
+			Expect(atomic.LoadInt32(&requests)).To(Equal(int32(2)))
+		})
+	})
+})
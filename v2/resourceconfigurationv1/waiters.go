@@ -0,0 +1,231 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourceconfigurationv1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/ibm-cos-sdk-go-config/v2/common"
+)
+
+// DefaultWaitPollInterval is the interval used between status checks when a waiter is not given one.
+const DefaultWaitPollInterval = 30 * time.Second
+
+// WaitForRestoreOptions : The WaitForRestore options.
+type WaitForRestoreOptions struct {
+	// name of BackupVault the restore was started from.
+	BackupVaultName *string `json:"backup_vault_name" validate:"required,ne="`
+
+	// id of the restore to wait for.
+	RestoreID *string `json:"restore_id" validate:"required,ne="`
+
+	// The time to wait between status checks. Defaults to DefaultWaitPollInterval.
+	PollInterval time.Duration
+
+	// Invoked with the latest state of the restore after every status check.
+	OnPoll func(restore *Restore)
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewWaitForRestoreOptions : Instantiate WaitForRestoreOptions
+func (*ResourceConfigurationV1) NewWaitForRestoreOptions(backupVaultName string, restoreID string) *WaitForRestoreOptions {
+	return &WaitForRestoreOptions{
+		BackupVaultName: core.StringPtr(backupVaultName),
+		RestoreID:       core.StringPtr(restoreID),
+	}
+}
+
+// SetPollInterval : Allow user to set PollInterval
+func (_options *WaitForRestoreOptions) SetPollInterval(pollInterval time.Duration) *WaitForRestoreOptions {
+	_options.PollInterval = pollInterval
+	return _options
+}
+
+// SetOnPoll : Allow user to set OnPoll
+func (_options *WaitForRestoreOptions) SetOnPoll(onPoll func(restore *Restore)) *WaitForRestoreOptions {
+	_options.OnPoll = onPoll
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *WaitForRestoreOptions) SetHeaders(param map[string]string) *WaitForRestoreOptions {
+	options.Headers = param
+	return options
+}
+
+// WaitForRestore : Wait for a restore to finish
+// Polls GetRestore until the restore reaches the `complete` or `failed` status. A restore that ends in `failed` is
+// returned together with an error describing the failure.
+func (resourceConfiguration *ResourceConfigurationV1) WaitForRestore(waitForRestoreOptions *WaitForRestoreOptions) (result *Restore, err error) {
+	result, err = resourceConfiguration.WaitForRestoreWithContext(context.Background(), waitForRestoreOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// WaitForRestoreWithContext is an alternate form of the WaitForRestore method which supports a Context parameter
+func (resourceConfiguration *ResourceConfigurationV1) WaitForRestoreWithContext(ctx context.Context, waitForRestoreOptions *WaitForRestoreOptions) (result *Restore, err error) {
+	err = core.ValidateNotNil(waitForRestoreOptions, "waitForRestoreOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(waitForRestoreOptions, "waitForRestoreOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	pollInterval := waitForRestoreOptions.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = DefaultWaitPollInterval
+	}
+
+	getRestoreOptions := resourceConfiguration.NewGetRestoreOptions(*waitForRestoreOptions.BackupVaultName, *waitForRestoreOptions.RestoreID)
+	getRestoreOptions.SetHeaders(waitForRestoreOptions.Headers)
+
+	for {
+		result, _, err = resourceConfiguration.GetRestoreWithContext(ctx, getRestoreOptions)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "wait-get-restore-error")
+			return
+		}
+		if waitForRestoreOptions.OnPoll != nil {
+			waitForRestoreOptions.OnPoll(result)
+		}
+
+		switch core.StringNilMapper(result.RestoreStatus) {
+		case Restore_RestoreStatus_Complete:
+			return
+		case Restore_RestoreStatus_Failed:
+			err = core.SDKErrorf(nil, fmt.Sprintf("restore %s failed: %s", *waitForRestoreOptions.RestoreID, core.StringNilMapper(result.ErrorCause)), "restore-failed", common.GetComponentInfo())
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			err = core.SDKErrorf(ctx.Err(), "", "wait-canceled", common.GetComponentInfo())
+			return
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+// WaitForBackupPolicyOptions : The WaitForBackupPolicy options.
+type WaitForBackupPolicyOptions struct {
+	// name of the bucket affected.
+	Bucket *string `json:"bucket" validate:"required,ne="`
+
+	// uuid of the BackupPolicy to wait for.
+	PolicyID *string `json:"policy_id" validate:"required,ne="`
+
+	// The time to wait between status checks. Defaults to DefaultWaitPollInterval.
+	PollInterval time.Duration
+
+	// Invoked with the latest state of the backup policy after every status check.
+	OnPoll func(backupPolicy *BackupPolicy)
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewWaitForBackupPolicyOptions : Instantiate WaitForBackupPolicyOptions
+func (*ResourceConfigurationV1) NewWaitForBackupPolicyOptions(bucket string, policyID string) *WaitForBackupPolicyOptions {
+	return &WaitForBackupPolicyOptions{
+		Bucket:   core.StringPtr(bucket),
+		PolicyID: core.StringPtr(policyID),
+	}
+}
+
+// SetPollInterval : Allow user to set PollInterval
+func (_options *WaitForBackupPolicyOptions) SetPollInterval(pollInterval time.Duration) *WaitForBackupPolicyOptions {
+	_options.PollInterval = pollInterval
+	return _options
+}
+
+// SetOnPoll : Allow user to set OnPoll
+func (_options *WaitForBackupPolicyOptions) SetOnPoll(onPoll func(backupPolicy *BackupPolicy)) *WaitForBackupPolicyOptions {
+	_options.OnPoll = onPoll
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *WaitForBackupPolicyOptions) SetHeaders(param map[string]string) *WaitForBackupPolicyOptions {
+	options.Headers = param
+	return options
+}
+
+// WaitForBackupPolicy : Wait for a backup policy to become active
+// Polls GetBackupPolicy until the policy reaches the `active` status. A policy that ends in `failed` or
+// `action_needed` is returned together with an error describing the failure.
+func (resourceConfiguration *ResourceConfigurationV1) WaitForBackupPolicy(waitForBackupPolicyOptions *WaitForBackupPolicyOptions) (result *BackupPolicy, err error) {
+	result, err = resourceConfiguration.WaitForBackupPolicyWithContext(context.Background(), waitForBackupPolicyOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// WaitForBackupPolicyWithContext is an alternate form of the WaitForBackupPolicy method which supports a Context parameter
+func (resourceConfiguration *ResourceConfigurationV1) WaitForBackupPolicyWithContext(ctx context.Context, waitForBackupPolicyOptions *WaitForBackupPolicyOptions) (result *BackupPolicy, err error) {
+	err = core.ValidateNotNil(waitForBackupPolicyOptions, "waitForBackupPolicyOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(waitForBackupPolicyOptions, "waitForBackupPolicyOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	pollInterval := waitForBackupPolicyOptions.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = DefaultWaitPollInterval
+	}
+
+	getBackupPolicyOptions := resourceConfiguration.NewGetBackupPolicyOptions(*waitForBackupPolicyOptions.Bucket, *waitForBackupPolicyOptions.PolicyID)
+	getBackupPolicyOptions.SetHeaders(waitForBackupPolicyOptions.Headers)
+
+	for {
+		result, _, err = resourceConfiguration.GetBackupPolicyWithContext(ctx, getBackupPolicyOptions)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "wait-get-backup-policy-error")
+			return
+		}
+		if waitForBackupPolicyOptions.OnPoll != nil {
+			waitForBackupPolicyOptions.OnPoll(result)
+		}
+
+		switch status := core.StringNilMapper(result.PolicyStatus); status {
+		case BackupPolicy_PolicyStatus_Active:
+			return
+		case BackupPolicy_PolicyStatus_Failed, BackupPolicy_PolicyStatus_ActionNeeded:
+			err = core.SDKErrorf(nil, fmt.Sprintf("backup policy %s is %s: %s", *waitForBackupPolicyOptions.PolicyID, status, core.StringNilMapper(result.ErrorCause)), "backup-policy-failed", common.GetComponentInfo())
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			err = core.SDKErrorf(ctx.Err(), "", "wait-canceled", common.GetComponentInfo())
+			return
+		case <-time.After(pollInterval):
+		}
+	}
+}
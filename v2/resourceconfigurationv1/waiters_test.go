@@ -0,0 +1,196 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourceconfigurationv1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/ibm-cos-sdk-go-config/v2/resourceconfigurationv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ResourceConfigurationV1 waiters`, func() {
+	var testServer *httptest.Server
+	Describe(`WaitForRestore(waitForRestoreOptions *WaitForRestoreOptions)`, func() {
+		getRestorePath := "/backup_vaults/testString/restores/testString"
+		var statuses []string
+		var polls int
+		BeforeEach(func() {
+			polls = 0
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				Expect(req.URL.EscapedPath()).To(Equal(getRestorePath))
+				Expect(req.Method).To(Equal("GET"))
+				status := statuses[polls]
+				if polls < len(statuses)-1 {
+					polls++
+				}
+				res.Header().Set("Content-type", "application/json")
+				res.WriteHeader(200)
+				fmt.Fprintf(res, `{"recovery_range_id": "RecoveryRangeID", "restore_type": "in_place", "restore_point_in_time": "2019-01-01T12:00:00.000Z", "target_resource_crn": "TargetResourceCrn", "restore_id": "testString", "restore_status": "%s", "error_cause": "ErrorCause"}`, status)
+			}))
+		})
+		AfterEach(func() {
+			testServer.Close()
+		})
+		It(`Invoke WaitForRestore successfully`, func() {
+			statuses = []string{"initializing", "running", "complete"}
+			resourceConfigurationService, serviceErr := resourceconfigurationv1.NewResourceConfigurationV1(&resourceconfigurationv1.ResourceConfigurationV1Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			var seen []string
+			waitForRestoreOptionsModel := resourceConfigurationService.NewWaitForRestoreOptions("testString", "testString")
+			waitForRestoreOptionsModel.SetPollInterval(time.Millisecond)
+			waitForRestoreOptionsModel.SetOnPoll(func(restore *resourceconfigurationv1.Restore) {
+				seen = append(seen, *restore.RestoreStatus)
+			})
+			result, operationErr := resourceConfigurationService.WaitForRestore(waitForRestoreOptionsModel)
+			Expect(operationErr).To(BeNil())
+			Expect(result).ToNot(BeNil())
+			Expect(*result.RestoreStatus).To(Equal("complete"))
+			Expect(seen).To(Equal(statuses))
+		})
+		It(`Invoke WaitForRestore with error: restore failed`, func() {
+			statuses = []string{"running", "failed"}
+			resourceConfigurationService, serviceErr := resourceconfigurationv1.NewResourceConfigurationV1(&resourceconfigurationv1.ResourceConfigurationV1Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			waitForRestoreOptionsModel := resourceConfigurationService.NewWaitForRestoreOptions("testString", "testString")
+			waitForRestoreOptionsModel.SetPollInterval(time.Millisecond)
+			result, operationErr := resourceConfigurationService.WaitForRestore(waitForRestoreOptionsModel)
+			Expect(operationErr).ToNot(BeNil())
+			Expect(operationErr.Error()).To(ContainSubstring("ErrorCause"))
+			Expect(*result.RestoreStatus).To(Equal("failed"))
+		})
+		It(`Invoke WaitForRestore with error: context canceled`, func() {
+			statuses = []string{"running"}
+			resourceConfigurationService, serviceErr := resourceconfigurationv1.NewResourceConfigurationV1(&resourceconfigurationv1.ResourceConfigurationV1Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+			defer cancel()
+			waitForRestoreOptionsModel := resourceConfigurationService.NewWaitForRestoreOptions("testString", "testString")
+			waitForRestoreOptionsModel.SetPollInterval(5 * time.Millisecond)
+			_, operationErr := resourceConfigurationService.WaitForRestoreWithContext(ctx, waitForRestoreOptionsModel)
+			Expect(operationErr).ToNot(BeNil())
+		})
+		It(`Invoke WaitForRestore with error: Operation validation error`, func() {
+			resourceConfigurationService, serviceErr := resourceconfigurationv1.NewResourceConfigurationV1(&resourceconfigurationv1.ResourceConfigurationV1Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			result, operationErr := resourceConfigurationService.WaitForRestore(nil)
+			Expect(operationErr).ToNot(BeNil())
+			Expect(result).To(BeNil())
+
+			result, operationErr = resourceConfigurationService.WaitForRestore(new(resourceconfigurationv1.WaitForRestoreOptions))
+			Expect(operationErr).ToNot(BeNil())
+			Expect(result).To(BeNil())
+		})
+	})
+	Describe(`WaitForBackupPolicy(waitForBackupPolicyOptions *WaitForBackupPolicyOptions)`, func() {
+		getBackupPolicyPath := "/buckets/testString/backup_policies/testString"
+		var statuses []string
+		var polls int
+		BeforeEach(func() {
+			polls = 0
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				Expect(req.URL.EscapedPath()).To(Equal(getBackupPolicyPath))
+				Expect(req.Method).To(Equal("GET"))
+				status := statuses[polls]
+				if polls < len(statuses)-1 {
+					polls++
+				}
+				res.Header().Set("Content-type", "application/json")
+				res.WriteHeader(200)
+				fmt.Fprintf(res, `{"initial_retention": {"delete_after_days": 1}, "policy_name": "PolicyName", "target_backup_vault_crn": "TargetBackupVaultCrn", "backup_type": "continuous", "policy_id": "testString", "policy_status": "%s", "error_cause": "ErrorCause"}`, status)
+			}))
+		})
+		AfterEach(func() {
+			testServer.Close()
+		})
+		It(`Invoke WaitForBackupPolicy successfully`, func() {
+			statuses = []string{"pending", "initializing", "active"}
+			resourceConfigurationService, serviceErr := resourceconfigurationv1.NewResourceConfigurationV1(&resourceconfigurationv1.ResourceConfigurationV1Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			var seen []string
+			waitForBackupPolicyOptionsModel := resourceConfigurationService.NewWaitForBackupPolicyOptions("testString", "testString")
+			waitForBackupPolicyOptionsModel.SetPollInterval(time.Millisecond)
+			waitForBackupPolicyOptionsModel.SetOnPoll(func(backupPolicy *resourceconfigurationv1.BackupPolicy) {
+				seen = append(seen, *backupPolicy.PolicyStatus)
+			})
+			result, operationErr := resourceConfigurationService.WaitForBackupPolicy(waitForBackupPolicyOptionsModel)
+			Expect(operationErr).To(BeNil())
+			Expect(*result.PolicyStatus).To(Equal("active"))
+			Expect(seen).To(Equal(statuses))
+		})
+		It(`Invoke WaitForBackupPolicy with error: policy failed`, func() {
+			statuses = []string{"initializing", "action_needed"}
+			resourceConfigurationService, serviceErr := resourceconfigurationv1.NewResourceConfigurationV1(&resourceconfigurationv1.ResourceConfigurationV1Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			waitForBackupPolicyOptionsModel := resourceConfigurationService.NewWaitForBackupPolicyOptions("testString", "testString")
+			waitForBackupPolicyOptionsModel.SetPollInterval(time.Millisecond)
+			result, operationErr := resourceConfigurationService.WaitForBackupPolicy(waitForBackupPolicyOptionsModel)
+			Expect(operationErr).ToNot(BeNil())
+			Expect(operationErr.Error()).To(ContainSubstring("action_needed: ErrorCause"))
+			Expect(*result.PolicyStatus).To(Equal("action_needed"))
+		})
+		It(`Invoke WaitForBackupPolicy with error: Operation validation error`, func() {
+			resourceConfigurationService, serviceErr := resourceconfigurationv1.NewResourceConfigurationV1(&resourceconfigurationv1.ResourceConfigurationV1Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			result, operationErr := resourceConfigurationService.WaitForBackupPolicy(nil)
+			Expect(operationErr).ToNot(BeNil())
+			Expect(result).To(BeNil())
+
+			result, operationErr = resourceConfigurationService.WaitForBackupPolicy(new(resourceconfigurationv1.WaitForBackupPolicyOptions))
+			Expect(operationErr).ToNot(BeNil())
+			Expect(result).To(BeNil())
+		})
+	})
+})